@@ -2,8 +2,10 @@ package assert
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
-	"unicode"
+	"regexp"
+	"strings"
 )
 
 //Errors
@@ -12,99 +14,179 @@ var (
 	ErrUnxpectedNil = errors.New("Unexpected nil")
 )
 
-type tb interface {
-	Error(args ...interface{})
-	Errorf(format string, args ...interface{})
-	Fail()
-	FailNow()
-	Failed() bool
-	Fatal(args ...interface{})
-	Fatalf(format string, args ...interface{})
-	Log(args ...interface{})
-	Logf(format string, args ...interface{})
-	Name() string
-	Skip(args ...interface{})
-	SkipNow()
-	Skipf(format string, args ...interface{})
-	Skipped() bool
-	Helper()
-}
-
 //StructAssert contains methods for verifying the structure
 type StructAssert struct {
-	t      tb
+	t      Reporter
 	vtype  reflect.Type
 	value  interface{}
 	failed bool
 	fields map[string]*Field
+	parent string
+
+	options    []Option
+	unexported bool
+	failFast   bool
+	collector  *collector
+	//tagNames overrides defaultTagNames for the UniqueTagValues
+	tagNames map[string]NamingStrategy
+	//message is the context added to the failures by the one-shot helpers
+	message string
+}
+
+//Option configures StructAssert
+type Option func(*StructAssert)
+
+//WithUnexported allows the assertions on unexported fields
+func WithUnexported() Option {
+	return func(a *StructAssert) {
+		a.unexported = true
+	}
+}
+
+//FailFast stops the test with FailNow on the first failed assertion
+func FailFast() Option {
+	return func(a *StructAssert) {
+		a.failFast = true
+	}
+}
+
+//Require waiting for a structure to verify assert, the first failed assertion stops the test
+func Require(t Reporter, v interface{}, opts ...Option) *StructAssert {
+	t.Helper()
+	return Expect(t, v, append([]Option{FailFast()}, opts...)...)
 }
 
 //Expect waiting for a structure to verify assert
-func Expect(t tb, v interface{}) *StructAssert {
+func Expect(t Reporter, v interface{}, opts ...Option) *StructAssert {
 	t.Helper()
+	check := newStructAssert(t, opts)
+	check.value = v
+	if v != nil {
+		check.vtype = reflect.TypeOf(v)
+	}
+	return check.assertStruct()
+}
+
+//ExpectType waiting for a structure type T to verify assert without a value of it
+func ExpectType[T any](t Reporter, opts ...Option) *StructAssert {
+	t.Helper()
+	return ExpectReflectType(t, reflect.TypeOf((*T)(nil)).Elem(), opts...)
+}
+
+//ExpectReflectType waiting for a structure type to verify assert without a value of it,
+//the type may be built with reflect.StructOf
+func ExpectReflectType(t Reporter, vtype reflect.Type, opts ...Option) *StructAssert {
+	t.Helper()
+	check := newStructAssert(t, opts)
+	check.vtype = vtype
+	return check.assertStruct()
+}
+
+//Expect waiting for a structure to verify assert with the options of the current one
+func (a *StructAssert) Expect(v interface{}, opts ...Option) *StructAssert {
+	a.t.Helper()
+	return Expect(a.t, v, append(append([]Option(nil), a.options...), opts...)...)
+}
 
+func newStructAssert(t Reporter, opts []Option) *StructAssert {
 	check := &StructAssert{
-		t:      t,
-		value:  v,
-		fields: make(map[string]*Field),
+		t:         t,
+		fields:    make(map[string]*Field),
+		options:   opts,
+		collector: &collector{},
 	}
-	return check.assertStruct()
+	for _, opt := range opts {
+		opt(check)
+	}
+	return check
 }
 
-//Expect waiting for a structure to verify assert
-func (a *StructAssert) Expect(v interface{}) *StructAssert {
+//fail records the failed assertion and reports it unless the failures are collected.
+//In the FailFast mode the test is stopped
+func (a *StructAssert) fail(failure Failure) {
 	a.t.Helper()
-	return Expect(a.t, v)
+	if a.collector != nil {
+		a.collector.failures = append(a.collector.failures, failure)
+	}
+	if a.collector == nil || !a.collector.collect {
+		a.report(failure)
+	}
+	if a.failFast {
+		a.t.FailNow()
+	}
 }
 
-func (a *StructAssert) assertStruct() *StructAssert {
+//failField reports the failure of the field found by the structure: "<Struct>: Field <name> <problem>"
+func (a *StructAssert) failField(name, problem string) {
 	a.t.Helper()
-	value := reflect.ValueOf(a.value)
+	a.fail(Failure{
+		Struct:  a.structName(),
+		Field:   a.structName() + "." + name,
+		Problem: problem,
+	})
+}
 
-	if value.Kind() == reflect.Invalid {
-		a.failed = true
-		a.t.Fatal(ErrUnxpectedNil)
-		return a
+//report sends the failure to the Reporter as the typed failure or as the message
+func (a *StructAssert) report(failure Failure) {
+	a.t.Helper()
+	format, args := failure.format()
+	if a.message != "" {
+		format += "\nMessages: %s"
+		args = append(args, a.message)
+	}
+	if r, ok := a.t.(failureReporter); ok {
+		r.reportFailure(failure, fmt.Sprintf(format, args...))
+		return
 	}
+	a.t.Errorf(format, args...)
+}
 
-	vtype := value.Type()
-	a.vtype = vtype
-	if vtype.Kind() == reflect.Ptr {
-		vtype = vtype.Elem()
+//fatal reports the error and stops the test with Fatal if the Reporter has it
+func (a *StructAssert) fatal(err error) {
+	a.t.Helper()
+	if t, ok := a.t.(fataler); ok {
+		t.Fatal(err)
+		return
 	}
+	a.t.Errorf("%v", err)
+	a.t.FailNow()
+}
 
-	if vtype.Kind() != reflect.Struct {
+func (a *StructAssert) assertStruct() *StructAssert {
+	a.t.Helper()
+	if a.vtype == nil {
 		a.failed = true
-		a.t.Fatal(ErrNotStruct)
+		a.fatal(ErrUnxpectedNil)
+		return a
+	}
+
+	if a.structType().Kind() != reflect.Struct {
+		a.failed = true
+		a.fatal(ErrNotStruct)
 	}
 
 	return a
 }
 
+//lookupError describes why the field at the path cannot be found
+type lookupError struct {
+	notFound bool
+	path     string
+	problem  string
+}
+
 func (a *StructAssert) mustStructField(name string) (*Field, bool) {
 	a.t.Helper()
 	if field, ok := a.fields[name]; ok {
 		return field, true
 	}
-
-	value := reflect.ValueOf(a.value)
-	vtype := value.Type()
-	if vtype.Kind() == reflect.Ptr {
-		vtype = vtype.Elem()
-	}
-
-	nameStruct := vtype.Name()
-	if nameStruct == "" {
-		nameStruct = "Unnamed"
-	}
-
-	structField, ok := vtype.FieldByName(name)
-	if !ok {
-		a.t.Errorf("%s: Field <%s> not found", nameStruct, name)
+	if a.failed {
 		return nil, false
 	}
-	if unicode.IsLower(rune(name[0])) {
-		a.t.Errorf("%s: Field <%s> is private", nameStruct, name)
+
+	structField, err := a.lookupField(name)
+	if err != nil {
+		a.failField(err.path, err.problem)
 		return nil, false
 	}
 	a.fields[name] = &Field{
@@ -115,24 +197,129 @@ func (a *StructAssert) mustStructField(name string) (*Field, bool) {
 	return a.fields[name], true
 }
 
+//lookupField walks the path of the field through the nested structures
+func (a *StructAssert) lookupField(name string) (reflect.StructField, *lookupError) {
+	fail := func(notFound bool, path, problem string) (reflect.StructField, *lookupError) {
+		return reflect.StructField{}, &lookupError{
+			notFound: notFound,
+			path:     path,
+			problem:  problem,
+		}
+	}
+
+	segments, ok := parseFieldPath(name)
+	if !ok {
+		return fail(true, name, "has a malformed path")
+	}
+
+	var structField reflect.StructField
+	vtype := a.structType()
+	path := ""
+	for _, segment := range segments {
+		if path != "" {
+			path += "."
+		}
+		path += segment.name
+
+		for vtype.Kind() == reflect.Ptr {
+			vtype = vtype.Elem()
+		}
+		if vtype.Kind() != reflect.Struct {
+			return fail(true, strings.TrimSuffix(path, "."+segment.name), "is not a struct")
+		}
+		structField, ok = vtype.FieldByName(segment.name)
+		if !ok {
+			return fail(true, path, "not found")
+		}
+		if structField.PkgPath != "" && !a.unexported {
+			return fail(false, path, "is private")
+		}
+
+		vtype = structField.Type
+		for _, container := range segment.containers {
+			path += container
+			for vtype.Kind() == reflect.Ptr {
+				vtype = vtype.Elem()
+			}
+			switch {
+			case container == pathSlice && (vtype.Kind() == reflect.Slice || vtype.Kind() == reflect.Array):
+			case container == pathMap && vtype.Kind() == reflect.Map:
+			case container == pathSlice:
+				return fail(true, strings.TrimSuffix(path, container), "is not a slice or array")
+			default:
+				return fail(true, strings.TrimSuffix(path, container), "is not a map")
+			}
+			vtype = vtype.Elem()
+		}
+	}
+	return structField, nil
+}
+
+//structType returns the type of the structure
+func (a *StructAssert) structType() reflect.Type {
+	if a.vtype.Kind() == reflect.Ptr {
+		return a.vtype.Elem()
+	}
+	return a.vtype
+}
+
+//structName returns the name of the structure for messages
+func (a *StructAssert) structName() string {
+	if a.parent != "" {
+		return a.parent
+	}
+	if a.vtype == nil {
+		return "Unnamed"
+	}
+	if name := typeName(a.structType()); name != "" {
+		return name
+	}
+	return "Unnamed"
+}
+
+//typeArgQualifier matches the package path of the type arguments: "Page[example.com/app.User]"
+var typeArgQualifier = regexp.MustCompile(`[^\[\]\s,*()]*\.`)
+
+//typeName returns the name of the type without the package paths of the type arguments: "Page[User]"
+func typeName(t reflect.Type) string {
+	name := t.Name()
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		return name[:i] + typeArgQualifier.ReplaceAllString(name[i:], "")
+	}
+	return name
+}
+
 //HasField checks the existence of a field in the structure
 func (a *StructAssert) HasField(name string) *StructAssert {
 	a.mustStructField(name)
 	return a
 }
 
-//ExpectField waiting for a field with name to verify assert
+//NoField checks the absence of a field in the structure
+func (a *StructAssert) NoField(name string) *StructAssert {
+	a.t.Helper()
+	if a.failed {
+		return a
+	}
+	if _, err := a.lookupField(name); err == nil || !err.notFound {
+		a.failField(name, "is unexpected")
+	}
+	return a
+}
+
+//ExpectField waiting for a field with name to verify assert.
+//The name may be a path to a nested field: "Address.City", "Items[].SKU" or "Meta{}.Owner",
+//where [] descends into the elements of a slice or an array and {} into the values of a map.
+//Pointers are dereferenced implicitly
 func (a *StructAssert) ExpectField(name string) *Field {
 	a.t.Helper()
 	structField, ok := a.mustStructField(name)
 	if ok {
 		return structField
 	}
-	//TODO: mark not found
-	structField = &Field{
-		name:        name,
-		structField: nil,
-		assert:      a,
+	return &Field{
+		name:     name,
+		assert:   a,
+		notFound: true,
 	}
-	return structField
 }
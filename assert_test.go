@@ -1,6 +1,7 @@
 package assert
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -9,7 +10,7 @@ import (
 
 type testAssert struct {
 	mockT      *MockTB
-	t          tb
+	t          TB
 	controller *gomock.Controller
 }
 
@@ -175,3 +176,152 @@ func TestHasValue(t *testing.T) {
 		t.Error("Unexpected has")
 	}
 }
+
+//nolint
+type UnicodeStruct struct {
+	Ärger  string `json:"ärger"`
+	ärger  string `db:"aerger"`
+	_Under string `db:"under"`
+}
+
+func TestWithUnexported(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, TestStruct{}, WithUnexported())
+	assert.ExpectField("private").NoTag("tag1")
+	assert.HasField("private")
+	assert.Expect(&TestStruct{}).HasField("private")
+	assert.ExpectField("SubStruct").Struct().HasField("Name")
+
+	unicode := Expect(test.t, UnicodeStruct{}, WithUnexported())
+	unicode.ExpectField("ärger").Assert("db", "aerger")
+	unicode.ExpectField("_Under").Assert("db", "under")
+	unicode.Naming("db", func(name string) string {
+		return map[string]string{"ärger": "aerger", "_Under": "under"}[name]
+	})
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is private", "TestStruct", "private")
+	assert.Expect(TestStruct{}, func(a *StructAssert) {
+		a.unexported = false
+	}).HasField("private")
+}
+
+func TestExportedUnicodeField(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, UnicodeStruct{})
+	assert.ExpectField("Ärger").Assert("json", "ärger")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is private", "UnicodeStruct", "ärger")
+	assert.HasField("ärger")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is private", "UnicodeStruct", "_Under")
+	assert.HasField("_Under")
+}
+
+//nolint
+type (
+	Page[T any] struct {
+		Items []T `json:"items"`
+		Total int `json:"total"`
+	}
+	Pair[K comparable, V any] struct {
+		Key   K `json:"key"`
+		Value V `json:"value"`
+	}
+)
+
+func TestExpectType(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	ExpectType[TestStruct](test.t).ExpectField("Public").Assert("tag1", "pub")
+	ExpectType[*TestStruct](test.t).HasField("Public")
+	ExpectType[Page[TestStruct]](test.t).ExpectField("Items").Assert("json", "items")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a value of <%s>,but actual <%s>",
+		"Page[TestStruct].Total", "json", "count", "total")
+	ExpectType[Page[TestStruct]](test.t).ExpectField("Total").Assert("json", "count")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "Pair[string,*SubStruct]", "Name")
+	ExpectType[Pair[string, *SubStruct]](test.t).HasField("Name")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "Page[map[string][]TestStruct]", "Name")
+	ExpectType[Page[map[string][]TestStruct]](test.t).HasField("Name")
+
+	test.mockT.EXPECT().Fatal(ErrNotStruct)
+	ExpectType[fmt.Stringer](test.t)
+}
+
+func TestExpectReflectType(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	vtype := reflect.StructOf([]reflect.StructField{
+		{Name: "Name", Type: reflect.TypeOf(""), Tag: `json:"name"`},
+	})
+	ExpectReflectType(test.t, vtype).ExpectField("Name").Assert("json", "name")
+	ExpectReflectType(test.t, reflect.TypeOf(&TestStruct{})).HasField("Public")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "Unnamed", "ID")
+	ExpectReflectType(test.t, vtype).HasField("ID")
+
+	test.mockT.EXPECT().Fatal(ErrUnxpectedNil)
+	ExpectReflectType(test.t, nil)
+
+	test.mockT.EXPECT().Fatal(ErrNotStruct)
+	ExpectReflectType(test.t, reflect.TypeOf(1))
+}
+
+func TestRequire(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	require := Require(test.t, TestStruct{})
+	require.ExpectField("Public").Assert("tag1", "pub")
+
+	gomock.InOrder(
+		test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "TestStruct", "Missing"),
+		test.mockT.EXPECT().FailNow(),
+	)
+	require.HasField("Missing")
+
+	gomock.InOrder(
+		test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a value of <%s>,but actual <%s>", "TestStruct.Public", "tag1", "public", "pub"),
+		test.mockT.EXPECT().FailNow(),
+	)
+	require.ExpectField("Public").ExpectTag("tag1").Equal("public")
+
+	gomock.InOrder(
+		test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "TestStruct.SubStruct", "Missing"),
+		test.mockT.EXPECT().FailNow(),
+	)
+	require.ExpectField("SubStruct").Struct().HasField("Missing")
+
+	gomock.InOrder(
+		test.mockT.EXPECT().Errorf("%s: Field <%s> is private", "TestStruct", "private"),
+		test.mockT.EXPECT().FailNow(),
+	)
+	require.Expect(&TestStruct{}).HasField("private")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "TestStruct", "Missing")
+	Expect(test.t, TestStruct{}).HasField("Missing")
+
+	gomock.InOrder(
+		test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "TestStruct", "Missing"),
+		test.mockT.EXPECT().FailNow(),
+	)
+	ExpectType[TestStruct](test.t, FailFast()).HasField("Missing")
+}
@@ -0,0 +1,51 @@
+package assert
+
+import (
+	"reflect"
+)
+
+//Rule is a set of assertions on the structure run by Check
+type Rule func(a *StructAssert)
+
+//Error is a failed assertion returned by Check
+type Error struct {
+	Failure
+	message string
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+//Check runs the rules on the structure outside of tests and returns the failed assertions as errors.
+//The errors are *Error, ErrNotStruct or ErrUnxpectedNil
+func Check(v interface{}, rules ...Rule) []error {
+	return check(func(c Reporter) *StructAssert {
+		return Expect(c, v)
+	}, rules)
+}
+
+//CheckType runs the rules on the structure type T outside of tests and returns the failed assertions as errors
+func CheckType[T any](rules ...Rule) []error {
+	return CheckReflectType(reflect.TypeOf((*T)(nil)).Elem(), rules...)
+}
+
+//CheckReflectType runs the rules on the structure type outside of tests and returns the failed assertions as errors
+func CheckReflectType(vtype reflect.Type, rules ...Rule) []error {
+	return check(func(c Reporter) *StructAssert {
+		return ExpectReflectType(c, vtype)
+	}, rules)
+}
+
+//check collects the failures of the rules run on the structure returned by expect.
+//A rule is not stopped by FailFast, the failures after FailNow are dropped
+func check(expect func(c Reporter) *StructAssert, rules []Rule) []error {
+	c := NewErrorCollector()
+	a := expect(c)
+	if !a.failed {
+		for _, rule := range rules {
+			rule(a)
+		}
+	}
+	return c.Errors()
+}
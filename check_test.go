@@ -0,0 +1,93 @@
+package assert
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	rules := []Rule{
+		func(a *StructAssert) {
+			a.ExpectField("Public").Assert("tag1", "pub").Assert("tag2", "public")
+		},
+		func(a *StructAssert) {
+			a.HasField("SN").ExpectField("SubStruct").Struct().HasField("Name")
+		},
+	}
+	errs := Check(TestStruct{}, rules...)
+	expected := []string{
+		"TestStruct.Public: Tag <tag2> does not have a value of <public>,but actual <public,options>",
+		"TestStruct: Field <SN> not found",
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("Expected %d errors, got %v", len(expected), errs)
+	}
+	for i, err := range errs {
+		if err.Error() != expected[i] {
+			t.Errorf("Expected %q, got %q", expected[i], err)
+		}
+	}
+
+	var checkErr *Error
+	if !errors.As(errs[0], &checkErr) {
+		t.Fatalf("Expected *Error, got %T", errs[0])
+	}
+	failure := Failure{
+		Field:    "TestStruct.Public",
+		Tag:      "tag2",
+		Expected: "public",
+		Actual:   "public,options",
+		Problem:  "does not have a value of",
+	}
+	if checkErr.Failure != failure {
+		t.Errorf("Expected %+v, got %+v", failure, checkErr.Failure)
+	}
+
+	if errs := Check(&TestStruct{}, rules[0]); len(errs) != 1 {
+		t.Errorf("Expected one error, got %v", errs)
+	}
+	if errs := Check(TestStruct{}); len(errs) != 0 {
+		t.Errorf("Unexpected errors %v", errs)
+	}
+}
+
+func TestCheckFailFast(t *testing.T) {
+	calls := 0
+	errs := Check(TestStruct{}, func(a *StructAssert) {
+		a.Expect(TestStruct{}, FailFast()).HasField("SN").HasField("ID")
+		calls++
+	})
+	if len(errs) != 1 || errs[0].Error() != "TestStruct: Field <SN> not found" {
+		t.Errorf("Unexpected errors %v", errs)
+	}
+	if calls != 1 {
+		t.Errorf("Expected the rule to be run once, got %d", calls)
+	}
+}
+
+func TestCheckNotStruct(t *testing.T) {
+	rule := func(a *StructAssert) {
+		t.Error("Unexpected call of the rule")
+	}
+	if errs := Check(1, rule); len(errs) != 1 || !errors.Is(errs[0], ErrNotStruct) {
+		t.Errorf("Expected %v, got %v", ErrNotStruct, errs)
+	}
+	if errs := Check(nil, rule); len(errs) != 1 || !errors.Is(errs[0], ErrUnxpectedNil) {
+		t.Errorf("Expected %v, got %v", ErrUnxpectedNil, errs)
+	}
+	if errs := CheckReflectType(reflect.TypeOf(""), rule); len(errs) != 1 || !errors.Is(errs[0], ErrNotStruct) {
+		t.Errorf("Expected %v, got %v", ErrNotStruct, errs)
+	}
+}
+
+func TestCheckType(t *testing.T) {
+	errs := CheckType[Page[TestStruct]](func(a *StructAssert) {
+		a.ExpectField("Items").Assert("json", "items")
+		a.ExpectField("Total").Assert("json", "count")
+	})
+	expected := "Page[TestStruct].Total: Tag <json> does not have a value of <count>,but actual <total>"
+	if len(errs) != 1 || errs[0].Error() != expected {
+		t.Errorf("Expected %q, got %v", expected, errs)
+	}
+}
@@ -0,0 +1,105 @@
+package assert
+
+//CompareOptions configures the comparison of the tags of two structures
+type CompareOptions struct {
+	//Map returns the name of the field in the second structure for the name of the field in the first one,
+	//by default the fields are matched by name
+	Map func(name string) string
+	//Ignore lists the names of the fields of both structures to skip
+	Ignore []string
+	//NamesOnly compares the names in the tags without the options
+	NamesOnly bool
+}
+
+//CompareTags checks that the exported fields of two structures match by name and have the same values of the tags
+func CompareTags(t Reporter, a, b interface{}, names ...string) {
+	t.Helper()
+	CompareTagsWith(t, a, b, CompareOptions{}, names...)
+}
+
+//CompareTagsWith checks that the exported fields of two structures match and have the same values of the tags.
+//It reports the fields missing in the second structure, the extra fields of the second structure,
+//the tags missing in one of the fields and the differing values of the tags
+func CompareTagsWith(t Reporter, a, b interface{}, opts CompareOptions, names ...string) {
+	t.Helper()
+	left, right := Expect(t, a), Expect(t, b)
+	if left.failed || right.failed {
+		return
+	}
+
+	mapName := opts.Map
+	if mapName == nil {
+		mapName = func(name string) string {
+			return name
+		}
+	}
+	ignore := make(map[string]bool)
+	for _, name := range opts.Ignore {
+		ignore[name] = true
+	}
+
+	rightFields := make(map[string]*Field)
+	right.Fields().Exported().Each(func(f *Field) {
+		rightFields[f.name] = f
+	})
+
+	matched := make(map[string]bool)
+	left.Fields().Exported().Each(func(lf *Field) {
+		if ignore[lf.name] {
+			return
+		}
+		name := mapName(lf.name)
+		if ignore[name] {
+			return
+		}
+		rf, ok := rightFields[name]
+		if !ok {
+			left.fail(Failure{
+				Struct:   left.structName(),
+				Field:    lf.getFullName(),
+				Expected: right.structName(),
+				Problem:  "is missing in",
+			})
+			return
+		}
+		matched[name] = true
+		for _, key := range names {
+			lv, lok := lf.structField.Tag.Lookup(key)
+			rv, rok := rf.structField.Tag.Lookup(key)
+			switch {
+			case !lok && !rok:
+				continue
+			case !lok:
+				left.fail(Failure{Field: lf.getFullName(), Tag: key, Problem: "not found"})
+				continue
+			case !rok:
+				left.fail(Failure{Field: rf.getFullName(), Tag: key, Problem: "not found"})
+				continue
+			}
+			if opts.NamesOnly {
+				lv, _ = parseTagValue(lv)
+				rv, _ = parseTagValue(rv)
+			}
+			if lv != rv {
+				left.fail(Failure{
+					Field:    lf.getFullName(),
+					Tag:      key,
+					Expected: rv,
+					Actual:   lv,
+					Problem:  "differs from <" + rf.getFullName() + "> with the value of",
+				})
+			}
+		}
+	})
+
+	right.Fields().Exported().Each(func(rf *Field) {
+		if !ignore[rf.name] && !matched[rf.name] {
+			left.fail(Failure{
+				Struct:   right.structName(),
+				Field:    rf.getFullName(),
+				Expected: left.structName(),
+				Problem:  "is extra compared to",
+			})
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+//nolint
+type (
+	UserDTO struct {
+		ID        int    `json:"id" db:"id"`
+		Name      string `json:"name,omitempty" db:"name"`
+		Email     string `json:"email" db:"email"`
+		Password  string `json:"-"`
+		CreatedAt string `json:"created_at"`
+	}
+	UserModel struct {
+		ID        int    `json:"id" db:"id"`
+		Name      string `json:"name" db:"name"`
+		Email     string `json:"mail" db:"email"`
+		Hash      string `db:"hash"`
+		CreatedAt string `json:"created_at"`
+	}
+	UserRow struct {
+		RowID        int    `db:"id"`
+		RowName      string `db:"name"`
+		RowEmail     string `db:"email"`
+		RowCreatedAt string
+	}
+)
+
+func TestCompareTags(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> differs from <UserModel.Name> with the value of <%s>,but actual <%s>", "UserDTO.Name", "json", "name", "name,omitempty")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> differs from <UserModel.Email> with the value of <%s>,but actual <%s>", "UserDTO.Email", "json", "mail", "email")
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is missing in <%s>", "UserDTO", "Password", "UserModel")
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is extra compared to <%s>", "UserModel", "Hash", "UserDTO")
+	CompareTags(test.t, UserDTO{}, &UserModel{}, "json", "db")
+}
+
+func TestCompareTagsWith(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> differs from <UserModel.Email> with the value of <%s>,but actual <%s>", "UserDTO.Email", "json", "mail", "email")
+	CompareTagsWith(test.t, UserDTO{}, UserModel{}, CompareOptions{
+		Ignore:    []string{"Password", "Hash"},
+		NamesOnly: true,
+	}, "json", "db")
+
+	CompareTagsWith(test.t, UserModel{}, UserRow{}, CompareOptions{
+		Map: func(name string) string {
+			return "Row" + name
+		},
+		Ignore: []string{"Hash"},
+	}, "db")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is missing in <%s>", "UserModel", "Hash", "UserRow")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "UserRow.RowID", "json")
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is extra compared to <%s>", "UserRow", "RowEmail", "UserModel")
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is extra compared to <%s>", "UserRow", "RowCreatedAt", "UserModel")
+	CompareTagsWith(test.t, UserModel{}, UserRow{}, CompareOptions{
+		Map: func(name string) string {
+			return "Row" + name
+		},
+		Ignore: []string{"Name", "RowName", "Email", "CreatedAt"},
+	}, "json", "db")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "UserRow.RowCreatedAt", "json")
+	CompareTagsWith(test.t, UserRow{}, UserModel{}, CompareOptions{
+		Map: func(name string) string {
+			return strings.TrimPrefix(name, "Row")
+		},
+		Ignore: []string{"ID", "Name", "Email", "Hash"},
+	}, "json")
+}
@@ -1,33 +1,81 @@
 package assert
 
-import "reflect"
+import (
+	"reflect"
+	"sort"
+)
 
 //Field contains methods for verifying the field
 type Field struct {
 	assert      *StructAssert
 	name        string
 	structField *reflect.StructField
+	negate      bool
+	//notFound is set when the field is missing, the failure is already reported
+	notFound bool
+}
+
+//Not returns the field with the next assertion inverted: Assert, HasTag, HasTags, NoTag, NoTags, Empty
+//or a type assertion. The field itself is not changed, the other methods report the modifier as a failure
+func (f *Field) Not() *Field {
+	negated := *f
+	negated.negate = !f.negate
+	return &negated
+}
+
+//not returns the state of the Not modifier and resets it
+func (f *Field) not() bool {
+	negate := f.negate
+	f.negate = false
+	return negate
+}
+
+//notApplicable resets the Not modifier and reports it for the method which cannot be inverted
+func (f *Field) notApplicable(method string) {
+	f.assert.t.Helper()
+	if f.not() {
+		f.fail(Failure{Problem: "Not is not applicable to " + method})
+	}
 }
 
 //Assert checks the tag (name) with the specified value
 func (f *Field) Assert(name, value string) *Field {
 	f.assert.t.Helper()
+	if f.not() {
+		if f.structField == nil {
+			return f
+		}
+		if actual, ok := f.structField.Tag.Lookup(name); ok && actual == value {
+			f.fail(Failure{Tag: name, Expected: value, Problem: "must not have a value of"})
+		}
+		return f
+	}
 	t := f.ExpectTag(name)
 	if t.Field == nil {
 		return f
 	}
 
 	if !t.HasValue(value) {
-		f.assert.t.Errorf("%s: Tag <%s> does not have a value of <%s>,but actual <%s>", f.getFullName(), t.Name, value, t.Value)
+		t.fail(Failure{Expected: value, Actual: t.Value, Problem: "does not have a value of"})
 	}
 	return f
 }
 
+//fail reports the failure of the field
+func (f *Field) fail(failure Failure) {
+	f.assert.t.Helper()
+	failure.Field = f.getFullName()
+	f.assert.fail(failure)
+}
+
 func (f *Field) getFullName() string {
 	if f.assert == nil || f.assert.failed {
 		return f.name
 	}
-	structName := f.assert.vtype.Name()
+	if f.assert.parent != "" {
+		return f.assert.parent + "." + f.name
+	}
+	structName := typeName(f.assert.structType())
 	if structName == "" {
 		structName = "<Unnamed>"
 	}
@@ -37,14 +85,15 @@ func (f *Field) getFullName() string {
 //ExpectTag waiting for a tag with name to verify assert
 func (f *Field) ExpectTag(name string) *Tag {
 	f.assert.t.Helper()
+	f.notApplicable("ExpectTag")
 	if f.structField == nil {
-		f.assert.t.Errorf("%s: Tag <%s> not found", f.getFullName(), name)
+		f.tagNotFound(name)
 		return &Tag{Name: name}
 	}
 
 	value, ok := f.structField.Tag.Lookup(name)
 	if !ok {
-		f.assert.t.Errorf("%s: Tag <%s> not found", f.getFullName(), name)
+		f.tagNotFound(name)
 		return &Tag{Name: name}
 	}
 	return &Tag{
@@ -54,32 +103,195 @@ func (f *Field) ExpectTag(name string) *Tag {
 	}
 }
 
+//tagNotFound reports the missing tag and the syntax error of the raw tag if any
+func (f *Field) tagNotFound(name string) {
+	f.assert.t.Helper()
+	if f.notFound {
+		return
+	}
+	if f.structField != nil {
+		if _, err := parseStructTag(f.structField.Tag); err != nil {
+			f.fail(Failure{Tag: name, Problem: "not found, tag is malformed: " + err.Error()})
+			return
+		}
+	}
+	f.fail(Failure{Tag: name, Problem: "not found"})
+}
+
 //HasTag checks the existence of a tag in the field
 func (f *Field) HasTag(name string) *Field {
 	f.assert.t.Helper()
+	if f.not() {
+		return f.NoTag(name)
+	}
 	if f.structField == nil {
-		f.assert.t.Errorf("%s: Tag <%s> not found", f.getFullName(), name)
+		f.tagNotFound(name)
 		return f
 	}
 	_, ok := f.structField.Tag.Lookup(name)
 	if !ok {
-		f.assert.t.Errorf("%s: Tag <%s> not found", f.getFullName(), name)
+		f.tagNotFound(name)
 	}
 	return f
 }
 
 //HasTags checks the existence of tags in the field
 func (f *Field) HasTags(names ...string) *Field {
+	f.assert.t.Helper()
+	if f.not() {
+		return f.NoTags(names...)
+	}
 	for _, name := range names {
 		f.HasTag(name)
 	}
 	return f
 }
 
+//NoTag checks the absence of a tag in the field
+func (f *Field) NoTag(name string) *Field {
+	f.assert.t.Helper()
+	if f.not() {
+		return f.HasTag(name)
+	}
+	if f.structField == nil {
+		return f
+	}
+	if _, ok := f.structField.Tag.Lookup(name); ok {
+		f.fail(Failure{Tag: name, Problem: "is unexpected"})
+	}
+	return f
+}
+
+//NoTags checks the absence of tags in the field
+func (f *Field) NoTags(names ...string) *Field {
+	f.assert.t.Helper()
+	if f.not() {
+		return f.HasTags(names...)
+	}
+	for _, name := range names {
+		f.NoTag(name)
+	}
+	return f
+}
+
 //Empty verifies that the tag is empty
 func (f *Field) Empty() *Field {
-	if string(f.structField.Tag) != "" {
-		f.assert.t.Errorf("%s: Not empty", f.getFullName())
+	f.assert.t.Helper()
+	if f.structField == nil {
+		f.not()
+		return f
+	}
+	switch not := f.not(); {
+	case !not && string(f.structField.Tag) != "":
+		f.fail(Failure{Problem: "Not empty"})
+	case not && string(f.structField.Tag) == "":
+		f.fail(Failure{Problem: "Empty"})
+	}
+	return f
+}
+
+//tagPairs parses the raw tag of the field, a malformed tag is reported
+func (f *Field) tagPairs() ([]tagPair, bool) {
+	f.assert.t.Helper()
+	if f.structField == nil {
+		return nil, false
+	}
+	pairs, err := parseStructTag(f.structField.Tag)
+	if err != nil {
+		f.fail(Failure{Problem: "Tag is malformed: " + err.Error()})
+		return nil, false
+	}
+	return pairs, true
+}
+
+//OnlyTags checks that the field has exactly the tags with the names
+func (f *Field) OnlyTags(names ...string) *Field {
+	f.assert.t.Helper()
+	f.notApplicable("OnlyTags")
+	pairs, ok := f.tagPairs()
+	if !ok {
+		return f
+	}
+	expected := make(map[string]bool)
+	for _, name := range names {
+		expected[name] = true
+	}
+	actual := make(map[string]bool)
+	for _, pair := range pairs {
+		actual[pair.key] = true
+		if !expected[pair.key] {
+			f.fail(Failure{Tag: pair.key, Problem: "is unexpected"})
+		}
+	}
+	for _, name := range names {
+		if !actual[name] {
+			f.fail(Failure{Tag: name, Problem: "not found"})
+		}
 	}
 	return f
 }
+
+//ExactTags checks that the field has exactly the tags with the values
+func (f *Field) ExactTags(tags map[string]string) *Field {
+	f.assert.t.Helper()
+	f.notApplicable("ExactTags")
+	pairs, ok := f.tagPairs()
+	if !ok {
+		return f
+	}
+	actual := make(map[string]string)
+	for _, pair := range pairs {
+		actual[pair.key] = pair.value
+		if _, ok := tags[pair.key]; !ok {
+			f.fail(Failure{Tag: pair.key, Problem: "is unexpected"})
+		}
+	}
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value, ok := actual[name]
+		if !ok {
+			f.fail(Failure{Tag: name, Problem: "not found"})
+			continue
+		}
+		if value != tags[name] {
+			f.fail(Failure{Tag: name, Expected: tags[name], Actual: value, Problem: "does not have a value of"})
+		}
+	}
+	return f
+}
+
+//Struct waiting for the struct type of the field to verify assert.
+//Pointers, slices, arrays and map values are unwrapped
+func (f *Field) Struct() *StructAssert {
+	f.assert.t.Helper()
+	f.notApplicable("Struct")
+	child := &StructAssert{
+		t:          f.assert.t,
+		fields:     make(map[string]*Field),
+		failed:     true,
+		options:    f.assert.options,
+		unexported: f.assert.unexported,
+		failFast:   f.assert.failFast,
+		collector:  f.assert.collector,
+		tagNames:   f.assert.tagNames,
+		message:    f.assert.message,
+		parent:     f.getFullName(),
+	}
+	if f.structField == nil {
+		return child
+	}
+
+	vtype, containers := unwrapType(f.structField.Type)
+	if vtype.Kind() != reflect.Struct {
+		f.assert.failField(f.name+containers, "is not a struct")
+		return child
+	}
+	child.vtype = vtype
+	child.parent += containers
+	child.failed = false
+	return child
+}
@@ -1,6 +1,7 @@
 package assert
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -60,9 +61,303 @@ func TestTagEmpty(t *testing.T) {
 	defer test.tearDown()
 
 	test.mockT.EXPECT().Helper().AnyTimes()
-	test.mockT.EXPECT().Errorf("%s: Not empty", "<Unnamed>.Public")
+	test.mockT.EXPECT().Errorf("%s: Not empty", "TestStruct.Public")
 	Expect(test.t, &TestStruct{}).
 		ExpectField("Public").Empty()
 
 	Expect(test.t, &TestStruct{}).ExpectField("WithoutTags").Empty()
 }
+
+//nolint
+type Address struct {
+	City string `json:"city"`
+}
+
+//nolint
+type OrderItem struct {
+	SKU string `json:"sku"`
+}
+
+//nolint
+type Order struct {
+	Address  *Address
+	Items    []OrderItem
+	Pointers []*OrderItem
+	Matrix   [2][]OrderItem
+	Meta     map[string]*Address
+	Name     string
+}
+
+func TestExpectFieldPath(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, &Order{})
+	assert.ExpectField("Address.City").Assert("json", "city")
+	assert.ExpectField("Items[].SKU").Assert("json", "sku")
+	assert.ExpectField("Pointers[].SKU").Assert("json", "sku")
+	assert.ExpectField("Matrix[][].SKU").Assert("json", "sku")
+	assert.ExpectField("Meta{}.City").Assert("json", "city")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a value of <%s>,but actual <%s>", "Order.Items[].SKU", "json", "id", "sku")
+	assert.ExpectField("Items[].SKU").Assert("json", "id")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "Order", "Items[].ID")
+	assert.ExpectField("Items[].ID")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is not a struct", "Order", "Items")
+	assert.ExpectField("Items.SKU")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is not a slice or array", "Order", "Meta")
+	assert.ExpectField("Meta[].City")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is not a map", "Order", "Items")
+	assert.ExpectField("Items{}.SKU")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is not a struct", "Order", "Name")
+	assert.ExpectField("Name.First")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> has a malformed path", "Order", "Items[.SKU")
+	assert.ExpectField("Items[.SKU")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> has a malformed path", "Order", "Address..City")
+	assert.ExpectField("Address..City")
+}
+
+func TestParseFieldPath(t *testing.T) {
+	segments, ok := parseFieldPath("Items[]{}.Meta{}.Owner")
+	if !ok {
+		t.Fatal("Expected ok")
+	}
+	expected := []pathSegment{
+		{name: "Items", containers: []string{pathSlice, pathMap}},
+		{name: "Meta", containers: []string{pathMap}},
+		{name: "Owner"},
+	}
+	if !reflect.DeepEqual(segments, expected) {
+		t.Errorf("Expected %v, got %v", expected, segments)
+	}
+	for _, path := range []string{"", ".", "A.", "[]", "A[]B", "A[", "A}{"} {
+		if _, ok := parseFieldPath(path); ok {
+			t.Errorf("%q: expected malformed path", path)
+		}
+	}
+}
+
+func TestFieldStruct(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, &Order{})
+	items := assert.ExpectField("Items").Struct()
+	if items.failed {
+		t.Fatal("Unexpected failed")
+	}
+	if items.vtype != reflect.TypeOf(OrderItem{}) {
+		t.Errorf("Expected %v, got %v", reflect.TypeOf(OrderItem{}), items.vtype)
+	}
+	items.ExpectField("SKU").Assert("json", "sku")
+	assert.ExpectField("Address").Struct().ExpectField("City").Assert("json", "city")
+	assert.ExpectField("Matrix").Struct().HasField("SKU")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a value of <%s>,but actual <%s>", "Order.Items[].SKU", "json", "id", "sku")
+	items.ExpectField("SKU").Assert("json", "id")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a value of <%s>,but actual <%s>", "Order.Meta{}.City", "json", "town", "city")
+	assert.ExpectField("Meta").Struct().ExpectField("City").Assert("json", "town")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "Order.Items[]", "ID")
+	items.HasField("ID")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is not a struct", "Order", "Name")
+	if !assert.ExpectField("Name").Struct().failed {
+		t.Error("Expected failed")
+	}
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "Order", "Unknown")
+	missing := assert.ExpectField("Unknown").Struct()
+	if !missing.failed {
+		t.Error("Expected failed")
+	}
+	missing.HasField("SKU")
+}
+
+func TestNoField(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, &Order{})
+	assert.NoField("Unknown").
+		NoField("Address.Unknown").
+		NoField("Items[].ID").
+		NoField("Name.First")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is unexpected", "Order", "Items[].SKU")
+	assert.NoField("Items[].SKU")
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> is unexpected", "TestStruct", "private")
+	Expect(test.t, TestStruct{}).NoField("private")
+}
+
+func TestNoTag(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, TestStruct{})
+	assert.ExpectField("Public").NoTag("bson").NoTags("bson", "db")
+	assert.ExpectField("WithoutTags").NoTags("tag1", "tag2")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is unexpected", "TestStruct.Public", "tag1")
+	assert.ExpectField("Public").NoTag("tag1")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is unexpected", "TestStruct.Public", "tag2")
+	assert.ExpectField("Public").NoTags("bson", "tag2")
+}
+
+func TestFieldNot(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, TestStruct{})
+	field := assert.ExpectField("Public")
+	field.Not().HasTag("bson").
+		Not().HasTags("bson", "db").
+		Not().Assert("tag1", "public").
+		Not().Assert("bson", "pub").
+		Not().Empty().
+		HasTag("tag1")
+	assert.ExpectField("WithoutTags").Empty()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is unexpected", "TestStruct.Public", "tag1")
+	field.Not().HasTag("tag1")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is unexpected", "TestStruct.Public", "tag2")
+	field.Not().HasTags("bson", "tag2")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> must not have a value of <%s>", "TestStruct.Public", "tag1", "pub")
+	field.Not().Assert("tag1", "pub")
+
+	test.mockT.EXPECT().Errorf("%s: Empty", "TestStruct.WithoutTags")
+	assert.ExpectField("WithoutTags").Not().Empty()
+
+	field.Not().Not().HasTag("tag1")
+}
+
+func TestOnlyTags(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, TestStruct{})
+	assert.ExpectField("Public").OnlyTags("tag2", "tag1")
+	assert.ExpectField("WithoutTags").OnlyTags()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is unexpected", "TestStruct.Public", "tag2")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "TestStruct.Public", "json")
+	assert.ExpectField("Public").OnlyTags("tag1", "json")
+
+	field := Expect(test.t, structWithTag(`json:"a"xml:"b"`)).ExpectField("Name")
+	test.mockT.EXPECT().Errorf("%s: Tag is malformed: struct tag pairs are not separated by a space at position 8", "<Unnamed>.Name")
+	field.OnlyTags("json", "xml")
+}
+
+func TestExactTags(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, TestStruct{})
+	assert.ExpectField("Public").ExactTags(map[string]string{
+		"tag1": "pub",
+		"tag2": "public,options",
+	})
+	assert.ExpectField("WithoutTags").ExactTags(nil)
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is unexpected", "TestStruct.Public", "tag1")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "TestStruct.Public", "json")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a value of <%s>,but actual <%s>", "TestStruct.Public", "tag2", "public", "public,options")
+	assert.ExpectField("Public").ExactTags(map[string]string{
+		"json": "public",
+		"tag2": "public",
+	})
+}
+
+func TestMissingFieldReportedOnce(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, TestStruct{})
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "TestStruct", "SN").Times(1)
+	field := assert.ExpectField("SN").
+		Assert("xml", "SN").
+		Assert("json", "social_number").
+		HasTag("db").
+		HasTags("yaml", "toml").
+		OnlyTags("json").
+		Empty()
+	field.ExpectTag("json").Equal("social_number").NotEmpty().HasName("social_number")
+	field.Struct().ExpectField("Name").Assert("json", "name")
+
+	test.mockT.EXPECT().Errorf("%s: JSON key <sn> not found", "TestStruct")
+	assert.ExpectJSONField("sn").Assert("json", "sn").Empty()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "TestStruct.Public", "json")
+	assert.ExpectField("Public").ExpectTag("json").Equal("public").NotEmpty().Not().Equal("-")
+}
+
+func TestNotDoesNotLeak(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, TestStruct{})
+
+	//Not inverts NoTag and NoTags
+	assert.ExpectField("Public").Not().NoTag("tag1").Not().NoTags("tag1", "tag2").NoTag("json")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "TestStruct.Public", "json")
+	assert.ExpectField("Public").Not().NoTag("json")
+
+	//the methods without the negation report the modifier and drop it
+	test.mockT.EXPECT().Errorf("%s: Not is not applicable to OnlyTags", "TestStruct.Public")
+	assert.ExpectField("Public").Not().OnlyTags("tag1", "tag2").HasTag("tag1")
+	test.mockT.EXPECT().Errorf("%s: Not is not applicable to ExactTags", "TestStruct.Public")
+	assert.ExpectField("Public").Not().ExactTags(map[string]string{"tag1": "pub", "tag2": "public,options"}).HasTag("tag2")
+	test.mockT.EXPECT().Errorf("%s: Not is not applicable to ExpectTag", "TestStruct.Public")
+	assert.ExpectField("Public").Not().ExpectTag("tag1").Equal("pub")
+	test.mockT.EXPECT().Errorf("%s: Not is not applicable to WellFormed", "TestStruct.Public")
+	assert.ExpectField("Public").Not().WellFormed().HasTag("tag1")
+	test.mockT.EXPECT().Errorf("%s: Not is not applicable to Struct", "TestStruct.SubStruct")
+	assert.ExpectField("SubStruct").Not().Struct().HasField("Name")
+
+	//the cached field is not changed by Not
+	field := assert.ExpectField("Public")
+	field.Not()
+	field.HasTag("tag1")
+
+	//the early returns drop the modifier
+	test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "TestStruct", "Unknown")
+	missing := assert.ExpectField("Unknown").Not().IsType("").Not().Empty()
+	missing.Assert("json", "name")
+
+	tag := assert.ExpectField("Public").ExpectTag("tag1")
+	tag.Not()
+	tag.Equal("pub")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "TestStruct.Public", "unknown")
+	assert.ExpectField("Public").ExpectTag("unknown").Not().Equal("pub")
+}
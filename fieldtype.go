@@ -0,0 +1,153 @@
+package assert
+
+import (
+	"reflect"
+)
+
+//typeOf returns the type of v, v may be a reflect.Type itself
+func typeOf(v interface{}) reflect.Type {
+	if t, ok := v.(reflect.Type); ok {
+		return t
+	}
+	return reflect.TypeOf(v)
+}
+
+//typeString returns the name of the type, nil for the untyped nil
+func typeString(t reflect.Type) string {
+	if t == nil {
+		return "nil"
+	}
+	return t.String()
+}
+
+//interfaceOf returns the interface type from the pointer to the interface: (*fmt.Stringer)(nil).
+//ok is false if v is not an interface
+func interfaceOf(v interface{}) (t reflect.Type, ok bool) {
+	t = typeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Interface {
+		t = t.Elem()
+	}
+	return t, t != nil && t.Kind() == reflect.Interface
+}
+
+//IsType checks that the field has the type of v
+func (f *Field) IsType(v interface{}) *Field {
+	f.assert.t.Helper()
+	if f.structField == nil {
+		f.not()
+		return f
+	}
+	expected, actual := typeOf(v), f.structField.Type
+	switch not := f.not(); {
+	case !not && actual != expected:
+		f.fail(Failure{Expected: typeString(expected), Actual: actual.String(), Problem: "Field does not have a type of"})
+	case not && actual == expected:
+		f.fail(Failure{Expected: typeString(expected), Problem: "Field must not have a type of"})
+	}
+	return f
+}
+
+//Kind checks the kind of the type of the field
+func (f *Field) Kind(kind reflect.Kind) *Field {
+	f.assert.t.Helper()
+	if f.structField == nil {
+		f.not()
+		return f
+	}
+	actual := f.structField.Type.Kind()
+	switch not := f.not(); {
+	case !not && actual != kind:
+		f.fail(Failure{Expected: kind.String(), Actual: actual.String(), Problem: "Field does not have a kind of"})
+	case not && actual == kind:
+		f.fail(Failure{Expected: kind.String(), Problem: "Field must not have a kind of"})
+	}
+	return f
+}
+
+//IsPointer checks that the field is a pointer
+func (f *Field) IsPointer() *Field {
+	f.assert.t.Helper()
+	if f.structField == nil {
+		f.not()
+		return f
+	}
+	actual := f.structField.Type
+	switch not := f.not(); {
+	case !not && actual.Kind() != reflect.Ptr:
+		f.fail(Failure{Actual: actual.String(), Problem: "Field is not a pointer"})
+	case not && actual.Kind() == reflect.Ptr:
+		f.fail(Failure{Actual: actual.String(), Problem: "Field must not be a pointer"})
+	}
+	return f
+}
+
+//Implements checks that the type of the field implements the interface given as a pointer: (*fmt.Stringer)(nil)
+func (f *Field) Implements(iface interface{}) *Field {
+	f.assert.t.Helper()
+	if f.structField == nil {
+		f.not()
+		return f
+	}
+	expected, ok := interfaceOf(iface)
+	if !ok {
+		f.not()
+		f.fail(Failure{Expected: typeString(expected), Problem: "Field cannot implement a type that is not an interface"})
+		return f
+	}
+	actual := f.structField.Type
+	switch ok, not := actual.Implements(expected), f.not(); {
+	case !not && !ok:
+		f.fail(Failure{Expected: expected.String(), Actual: actual.String(), Problem: "Field does not implement"})
+	case not && ok:
+		f.fail(Failure{Expected: expected.String(), Actual: actual.String(), Problem: "Field must not implement"})
+	}
+	return f
+}
+
+//AssignableTo checks that the value of the field is assignable to the type of v.
+//An interface type is given as a pointer: (*io.Reader)(nil)
+func (f *Field) AssignableTo(v interface{}) *Field {
+	f.assert.t.Helper()
+	if f.structField == nil {
+		f.not()
+		return f
+	}
+	expected, actual := typeOf(v), f.structField.Type
+	if expected == nil {
+		f.not()
+		f.fail(Failure{Problem: "Field cannot be assigned to the untyped nil"})
+		return f
+	}
+	if expected.Kind() == reflect.Ptr && expected.Elem().Kind() == reflect.Interface {
+		expected = expected.Elem()
+	}
+	switch ok, not := actual.AssignableTo(expected), f.not(); {
+	case !not && !ok:
+		f.fail(Failure{Expected: expected.String(), Actual: actual.String(), Problem: "Field is not assignable to"})
+	case not && ok:
+		f.fail(Failure{Expected: expected.String(), Actual: actual.String(), Problem: "Field must not be assignable to"})
+	}
+	return f
+}
+
+//ElemType checks the element type of the pointer, slice, array, map or channel field
+func (f *Field) ElemType(v interface{}) *Field {
+	f.assert.t.Helper()
+	if f.structField == nil {
+		f.not()
+		return f
+	}
+	expected := typeOf(v)
+	var actual reflect.Type
+	switch f.structField.Type.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		actual = f.structField.Type.Elem()
+	}
+	switch not := f.not(); {
+	case !not && actual != expected:
+		f.fail(Failure{Expected: typeString(expected), Actual: typeString(actual), Problem: "Field does not have an element type of"})
+	case not && actual == expected:
+		f.fail(Failure{Expected: typeString(expected), Problem: "Field must not have an element type of"})
+	}
+	return f
+}
@@ -0,0 +1,80 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+//nolint
+type TypedStruct struct {
+	Name    string
+	Created time.Time
+	Updated *time.Time
+	Tags    []string
+	Meta    map[string]int
+	Err     error
+}
+
+func TestFieldTypeAssertions(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, TypedStruct{})
+	assert.ExpectField("Name").IsType("").Kind(reflect.String).Not().IsPointer()
+	assert.ExpectField("Created").IsType(time.Time{}).Implements((*fmt.Stringer)(nil)).Not().Kind(reflect.Ptr)
+	assert.ExpectField("Updated").IsPointer().ElemType(time.Time{}).IsType(reflect.TypeOf(&time.Time{}))
+	assert.ExpectField("Tags").ElemType("").Not().ElemType(0)
+	assert.ExpectField("Meta").ElemType(0).Kind(reflect.Map)
+	assert.ExpectField("Err").AssignableTo((*interface{})(nil)).Implements(reflect.TypeOf((*error)(nil)).Elem())
+	assert.ExpectField("Name").AssignableTo("").Not().AssignableTo(0).Not().Implements((*fmt.Stringer)(nil))
+
+	test.mockT.EXPECT().Errorf("%s: Field does not have a type of <%s>,but actual <%s>", "TypedStruct.Name", "int", "string")
+	assert.ExpectField("Name").IsType(0)
+
+	test.mockT.EXPECT().Errorf("%s: Field must not have a type of <%s>", "TypedStruct.Name", "string")
+	assert.ExpectField("Name").Not().IsType("")
+
+	test.mockT.EXPECT().Errorf("%s: Field does not have a kind of <%s>,but actual <%s>", "TypedStruct.Created", "ptr", "struct")
+	assert.ExpectField("Created").Kind(reflect.Ptr)
+
+	test.mockT.EXPECT().Errorf("%s: Field is not a pointer,but actual <%s>", "TypedStruct.Created", "time.Time")
+	assert.ExpectField("Created").IsPointer()
+
+	test.mockT.EXPECT().Errorf("%s: Field must not be a pointer,but actual <%s>", "TypedStruct.Updated", "*time.Time")
+	assert.ExpectField("Updated").Not().IsPointer()
+
+	test.mockT.EXPECT().Errorf("%s: Field does not implement <%s>,but actual <%s>", "TypedStruct.Name", "fmt.Stringer", "string")
+	assert.ExpectField("Name").Implements((*fmt.Stringer)(nil))
+
+	test.mockT.EXPECT().Errorf("%s: Field is not assignable to <%s>,but actual <%s>", "TypedStruct.Tags", "string", "[]string")
+	assert.ExpectField("Tags").AssignableTo("")
+
+	test.mockT.EXPECT().Errorf("%s: Field does not have an element type of <%s>,but actual <%s>", "TypedStruct.Name", "string", "nil")
+	assert.ExpectField("Name").ElemType("")
+
+	test.mockT.EXPECT().Errorf("%s: Field must not have an element type of <%s>", "TypedStruct.Tags", "string")
+	assert.ExpectField("Tags").Not().ElemType("")
+}
+
+func TestFieldTypeInvalidArguments(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, TypedStruct{})
+
+	test.mockT.EXPECT().Errorf("%s: Field cannot implement a type that is not an interface <%s>", "TypedStruct.Name", "string")
+	test.mockT.EXPECT().Errorf("%s: Field cannot implement a type that is not an interface <%s>", "TypedStruct.Name", "nil")
+	assert.ExpectField("Name").Implements("").Not().Implements(nil).IsType("")
+
+	test.mockT.EXPECT().Errorf("%s: Field cannot be assigned to the untyped nil", "TypedStruct.Err")
+	assert.ExpectField("Err").Not().AssignableTo(nil).IsType(reflect.TypeOf((*error)(nil)).Elem())
+
+	test.mockT.EXPECT().Errorf("%s: Field does not have a type of <%s>,but actual <%s>", "TypedStruct.Name", "nil", "string")
+	assert.ExpectField("Name").IsType(nil)
+}
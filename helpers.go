@@ -0,0 +1,74 @@
+package assert
+
+import (
+	"fmt"
+)
+
+//withMessage adds the context of msgAndArgs to the failures
+func withMessage(msgAndArgs []interface{}) Option {
+	return func(a *StructAssert) {
+		a.message = messageOf(msgAndArgs...)
+	}
+}
+
+//messageOf formats msgAndArgs as testify does: a message or a format with the arguments
+func messageOf(msgAndArgs ...interface{}) string {
+	switch len(msgAndArgs) {
+	case 0:
+		return ""
+	case 1:
+		if msg, ok := msgAndArgs[0].(string); ok {
+			return msg
+		}
+		return fmt.Sprintf("%+v", msgAndArgs[0])
+	}
+	if format, ok := msgAndArgs[0].(string); ok {
+		return fmt.Sprintf(format, msgAndArgs[1:]...)
+	}
+	return fmt.Sprint(msgAndArgs...)
+}
+
+//passed returns true if the structure is checked without failures
+func (a *StructAssert) passed() bool {
+	return !a.failed && len(a.Failures()) == 0
+}
+
+//FieldExists asserts that the structure has the field
+func FieldExists(t Reporter, v interface{}, field string, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	a := Expect(t, v, withMessage(msgAndArgs))
+	a.HasField(field)
+	return a.passed()
+}
+
+//HasTag asserts that the field of the structure has the tag
+func HasTag(t Reporter, v interface{}, field, tag string, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	a := Expect(t, v, withMessage(msgAndArgs))
+	a.ExpectField(field).HasTag(tag)
+	return a.passed()
+}
+
+//NoTag asserts that the field of the structure does not have the tag
+func NoTag(t Reporter, v interface{}, field, tag string, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	a := Expect(t, v, withMessage(msgAndArgs))
+	a.ExpectField(field).NoTag(tag)
+	return a.passed()
+}
+
+//TagEqual asserts that the tag of the field of the structure has the value
+func TagEqual(t Reporter, v interface{}, field, tag, value string, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	a := Expect(t, v, withMessage(msgAndArgs))
+	a.ExpectField(field).ExpectTag(tag).Equal(value)
+	return a.passed()
+}
+
+//TagHasOption asserts that the tag of the field of the structure has the option
+func TagHasOption(t Reporter, v interface{}, field, tag, option string, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	a := Expect(t, v, withMessage(msgAndArgs))
+	a.ExpectField(field).ExpectTag(tag).HasOption(option)
+	return a.passed()
+}
@@ -0,0 +1,79 @@
+package assert
+
+import (
+	"testing"
+)
+
+func TestMessageOf(t *testing.T) {
+	cases := []struct {
+		MsgAndArgs []interface{}
+		Expected   string
+	}{
+		{nil, ""},
+		{[]interface{}{"message"}, "message"},
+		{[]interface{}{"case %d: %s", 1, "json"}, "case 1: json"},
+		{[]interface{}{struct{ ID int }{1}}, "{ID:1}"},
+		{[]interface{}{1, 2}, "1 2"},
+	}
+	for _, c := range cases {
+		if msg := messageOf(c.MsgAndArgs...); msg != c.Expected {
+			t.Errorf("Expected %q, got %q", c.Expected, msg)
+		}
+	}
+}
+
+func TestHelpers(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	checks := []bool{
+		FieldExists(test.t, TestStruct{}, "Public"),
+		FieldExists(test.t, &TestStruct{}, "SubStruct.Name"),
+		HasTag(test.t, TestStruct{}, "Public", "tag1"),
+		NoTag(test.t, TestStruct{}, "Public", "json"),
+		TagEqual(test.t, TestStruct{}, "Public", "tag2", "public,options"),
+		TagHasOption(test.t, TestStruct{}, "Public", "tag2", "options"),
+	}
+	for i, ok := range checks {
+		if !ok {
+			t.Errorf("%d: expected true", i)
+		}
+	}
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> not found", "TestStruct", "SN")
+	if FieldExists(test.t, TestStruct{}, "SN") {
+		t.Error("FieldExists: expected false")
+	}
+
+	test.mockT.EXPECT().Errorf("%s: Field <%s> not found\nMessages: %s", "TestStruct", "SN", "case 1")
+	if TagEqual(test.t, TestStruct{}, "SN", "json", "sn", "case %d", 1) {
+		t.Error("TagEqual: expected false")
+	}
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found\nMessages: %s", "TestStruct.Public", "json", "dto")
+	if HasTag(test.t, TestStruct{}, "Public", "json", "dto") {
+		t.Error("HasTag: expected false")
+	}
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is unexpected", "TestStruct.Public", "tag1")
+	if NoTag(test.t, TestStruct{}, "Public", "tag1") {
+		t.Error("NoTag: expected false")
+	}
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a value of <%s>,but actual <%s>", "TestStruct.Public", "tag1", "public", "pub")
+	if TagEqual(test.t, TestStruct{}, "Public", "tag1", "public") {
+		t.Error("TagEqual: expected false")
+	}
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have an option <%s>", "TestStruct.Public", "tag2", "omitempty")
+	if TagHasOption(test.t, TestStruct{}, "Public", "tag2", "omitempty") {
+		t.Error("TagHasOption: expected false")
+	}
+
+	test.mockT.EXPECT().Fatal(ErrNotStruct)
+	if HasTag(test.t, 1, "Public", "tag1") {
+		t.Error("HasTag: expected false")
+	}
+}
@@ -0,0 +1,375 @@
+package assert
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const jsonKey = "json"
+
+//options of encoding/json and encoding/json/v2
+const (
+	jsonOmitEmpty  = "omitempty"
+	jsonOmitZero   = "omitzero"
+	jsonString     = "string"
+	jsonCaseIgnore = "case:ignore"
+	jsonCaseStrict = "case:strict"
+	jsonFormat     = "format:"
+	jsonInline     = "inline"
+	jsonUnknown    = "unknown"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+//JSONTag contains methods for verifying the json tag
+//with the semantics of encoding/json and encoding/json/v2
+type JSONTag struct {
+	*Tag
+	name    string
+	options []string
+	err     string
+}
+
+//ExpectJSON waiting for a json tag to verify assert
+func (f *Field) ExpectJSON() *JSONTag {
+	f.assert.t.Helper()
+	tag := &JSONTag{Tag: f.ExpectTag(jsonKey)}
+	tag.parse()
+	return tag
+}
+
+//parse splits the value of the json tag into the name and options.
+//The name may be single-quoted as allowed by encoding/json/v2
+func (j *JSONTag) parse() {
+	value := j.Value
+	if strings.HasPrefix(value, "'") {
+		end := quotedEnd(value)
+		if end < 0 {
+			j.err = "unterminated quoted name"
+			return
+		}
+		name, err := unquoteSingle(value[:end+1])
+		if err != nil {
+			j.err = "malformed quoted name"
+			return
+		}
+		j.name = name
+		value = value[end+1:]
+		if value != "" && value[0] != ',' {
+			j.err = "unexpected characters after quoted name"
+			return
+		}
+	} else {
+		i := strings.IndexByte(value, ',')
+		if i < 0 {
+			i = len(value)
+		}
+		j.name = value[:i]
+		value = value[i:]
+	}
+	for _, option := range splitQuoted(strings.TrimPrefix(value, ",")) {
+		if option != "" {
+			j.options = append(j.options, option)
+		}
+	}
+}
+
+//quotedEnd returns the index of the closing single quote of a string starting with a single quote
+func quotedEnd(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '\'':
+			return i
+		}
+	}
+	return -1
+}
+
+//unquoteSingle unquotes a single-quoted string with Go escape sequences
+func unquoteSingle(s string) (string, error) {
+	if len(s) < 2 || s[0] != '\'' || s[len(s)-1] != '\'' {
+		return "", strconv.ErrSyntax
+	}
+	return strconv.Unquote(`"` + strings.Replace(s[1:len(s)-1], `\'`, `'`, -1) + `"`)
+}
+
+//splitQuoted splits s by commas outside of single quotes
+func splitQuoted(s string) []string {
+	var parts []string
+	quoted := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if quoted {
+				i++
+			}
+		case '\'':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+//JSONOptions returns the options of the json tag
+func (j *JSONTag) JSONOptions() []string {
+	return j.options
+}
+
+//IsIgnored returns true if the field is ignored by encoding/json (json:"-")
+func (j *JSONTag) IsIgnored() bool {
+	return j.Field != nil && j.Value == "-"
+}
+
+//Key returns the name of the JSON object member for the field
+func (j *JSONTag) Key() string {
+	if j.IsIgnored() {
+		return ""
+	}
+	if j.name == "" && j.Field != nil && j.Field.structField != nil {
+		return j.Field.structField.Name
+	}
+	return j.name
+}
+
+//formatOption returns the value of the format option
+func (j *JSONTag) formatOption() (string, bool) {
+	for _, option := range j.options {
+		if strings.HasPrefix(option, jsonFormat) {
+			format := strings.TrimPrefix(option, jsonFormat)
+			if unquoted, err := unquoteSingle(format); err == nil {
+				format = unquoted
+			}
+			return format, true
+		}
+	}
+	return "", false
+}
+
+func (j *JSONTag) hasJSONOption(option string) bool {
+	for _, o := range j.options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+func (j *JSONTag) expectOption(option string) *JSONTag {
+	if j.Field == nil {
+		return j
+	}
+	j.Field.assert.t.Helper()
+	if !j.hasJSONOption(option) {
+		j.fail(Failure{Expected: option, Problem: "does not have an option"})
+	}
+	return j
+}
+
+//Ignored checks that the field is ignored by encoding/json (json:"-")
+func (j *JSONTag) Ignored() *JSONTag {
+	if j.Field == nil {
+		return j
+	}
+	j.Field.assert.t.Helper()
+	if !j.IsIgnored() {
+		j.fail(Failure{Actual: j.Value, Problem: "is not ignored"})
+	}
+	return j
+}
+
+//HasKey checks the name of the JSON object member for the field
+func (j *JSONTag) HasKey(key string) *JSONTag {
+	if j.Field == nil {
+		return j
+	}
+	j.Field.assert.t.Helper()
+	if actual := j.Key(); actual != key {
+		j.fail(Failure{Expected: key, Actual: actual, Problem: "does not have a key of"})
+	}
+	return j
+}
+
+//OmitEmpty checks the omitempty option
+func (j *JSONTag) OmitEmpty() *JSONTag {
+	return j.expectOption(jsonOmitEmpty)
+}
+
+//OmitZero checks the omitzero option
+func (j *JSONTag) OmitZero() *JSONTag {
+	return j.expectOption(jsonOmitZero)
+}
+
+//AsString checks the string option
+func (j *JSONTag) AsString() *JSONTag {
+	return j.expectOption(jsonString)
+}
+
+//CaseIgnore checks the case:ignore option of encoding/json/v2
+func (j *JSONTag) CaseIgnore() *JSONTag {
+	return j.expectOption(jsonCaseIgnore)
+}
+
+//CaseStrict checks the case:strict option of encoding/json/v2
+func (j *JSONTag) CaseStrict() *JSONTag {
+	return j.expectOption(jsonCaseStrict)
+}
+
+//Inline checks the inline option of encoding/json/v2
+func (j *JSONTag) Inline() *JSONTag {
+	return j.expectOption(jsonInline)
+}
+
+//Unknown checks the unknown option of encoding/json/v2
+func (j *JSONTag) Unknown() *JSONTag {
+	return j.expectOption(jsonUnknown)
+}
+
+//Format checks the format option of encoding/json/v2
+func (j *JSONTag) Format(format string) *JSONTag {
+	if j.Field == nil {
+		return j
+	}
+	j.Field.assert.t.Helper()
+	actual, ok := j.formatOption()
+	if !ok {
+		j.fail(Failure{Expected: jsonFormat + format, Problem: "does not have an option"})
+		return j
+	}
+	if actual != format {
+		j.fail(Failure{Expected: format, Actual: actual, Problem: "does not have a format of"})
+	}
+	return j
+}
+
+//Valid checks the syntax of the json tag and that every option is applicable to the type of the field
+func (j *JSONTag) Valid() *JSONTag {
+	if j.Field == nil || j.Field.structField == nil {
+		return j
+	}
+	j.Field.assert.t.Helper()
+	if j.err != "" {
+		j.fail(Failure{Problem: "is malformed: " + j.err})
+		return j
+	}
+	if j.IsIgnored() {
+		return j
+	}
+
+	ftype := j.Field.structField.Type
+	seen := make(map[string]bool)
+	for _, option := range j.options {
+		key := option
+		if strings.HasPrefix(option, jsonFormat) {
+			key = jsonFormat
+		}
+		if seen[key] {
+			j.fail(Failure{Expected: option, Problem: "has a duplicate option"})
+			continue
+		}
+		seen[key] = true
+
+		switch key {
+		case jsonOmitEmpty, jsonOmitZero:
+		case jsonCaseIgnore, jsonCaseStrict:
+		case jsonString:
+			if !jsonStringApplicable(ftype) {
+				j.fail(Failure{Expected: ftype.String(), Problem: "option <" + option + "> is not applicable to type"})
+			}
+		case jsonFormat:
+			format, _ := j.formatOption()
+			if !jsonFormatApplicable(ftype, format) {
+				j.fail(Failure{Expected: ftype.String(), Problem: "option <" + option + "> is not applicable to type"})
+			}
+		case jsonInline, jsonUnknown:
+			if !jsonInlineApplicable(ftype, key == jsonUnknown) {
+				j.fail(Failure{Expected: ftype.String(), Problem: "option <" + option + "> is not applicable to type"})
+			}
+		default:
+			j.fail(Failure{Expected: option, Problem: "has an unknown option"})
+		}
+	}
+
+	for _, pair := range [][2]string{
+		{jsonCaseIgnore, jsonCaseStrict},
+		{jsonInline, jsonUnknown},
+	} {
+		if seen[pair[0]] && seen[pair[1]] {
+			j.fail(Failure{Problem: "has conflicting options <" + pair[0] + "> and <" + pair[1] + ">"})
+		}
+	}
+	return j
+}
+
+//jsonStringApplicable reports whether the string option affects the type.
+//As in encoding/json an unnamed pointer is dereferenced once
+func jsonStringApplicable(t reflect.Type) bool {
+	if t.Name() == "" && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+//jsonFormatApplicable reports whether the format is supported by encoding/json/v2 for the type
+func jsonFormatApplicable(t reflect.Type, format string) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return format != ""
+	case t == durationType:
+		return oneOf(format, "sec", "milli", "micro", "nano", "units", "iso8601")
+	case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() == reflect.Uint8:
+		return oneOf(format, "base64", "base64url", "base32", "base32hex", "base16", "hex", "array")
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		return format == "nonfinite"
+	case t.Kind() == reflect.Map || t.Kind() == reflect.Slice:
+		return oneOf(format, "emitnull", "emitempty")
+	}
+	return false
+}
+
+//jsonInlineApplicable reports whether the inline or unknown option is supported for the type
+func jsonInlineApplicable(t reflect.Type, unknown bool) bool {
+	if t.Name() == "" && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		return !unknown
+	case reflect.Map:
+		return t.Key().Kind() == reflect.String
+	case reflect.Slice:
+		return t.Name() == "Value" && strings.HasSuffix(t.PkgPath(), "jsontext")
+	}
+	return false
+}
+
+func oneOf(s string, values ...string) bool {
+	for _, v := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
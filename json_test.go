@@ -0,0 +1,143 @@
+package assert
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+//nolint
+type JSONStruct struct {
+	ID       int               `json:"id,string,omitempty"`
+	Name     string            `json:"'name,full',omitzero,case:ignore"`
+	Ignored  string            `json:"-"`
+	Dash     string            `json:"-,"`
+	Default  string            `json:",omitempty"`
+	Created  time.Time         `json:"created,format:RFC3339"`
+	Timeout  time.Duration     `json:"timeout,format:sec"`
+	Data     []byte            `json:"data,format:base64"`
+	Extra    map[string]string `json:",unknown"`
+	Inner    SubStruct         `json:",inline"`
+	Flags    []bool            `json:"flags,string"`
+	Raw      []int             `json:"raw,format:hex"`
+	Both     string            `json:"both,case:ignore,case:strict"`
+	Wrong    string            `json:"wrong,omitempty,omitempty,unknownoption"`
+	BadQuote string            `json:"'bad"`
+}
+
+func TestParseJSONTag(t *testing.T) {
+	cases := []struct {
+		Value   string
+		Name    string
+		Options []string
+		Err     bool
+	}{
+		{Value: "id,string", Name: "id", Options: []string{"string"}},
+		{Value: "'a,b',omitempty", Name: "a,b", Options: []string{"omitempty"}},
+		{Value: `'it\'s'`, Name: "it's"},
+		{Value: "t,format:'2006-01-02,15'", Name: "t", Options: []string{"format:'2006-01-02,15'"}},
+		{Value: "'bad", Err: true},
+		{Value: "'bad'x", Err: true},
+	}
+	for _, c := range cases {
+		tag := &JSONTag{Tag: &Tag{Value: c.Value}}
+		tag.parse()
+		if (tag.err != "") != c.Err {
+			t.Errorf("%q: unexpected error %q", c.Value, tag.err)
+			continue
+		}
+		if c.Err {
+			continue
+		}
+		if tag.name != c.Name {
+			t.Errorf("%q: expected name %q, got %q", c.Value, c.Name, tag.name)
+		}
+		if !reflect.DeepEqual(tag.options, c.Options) {
+			t.Errorf("%q: expected options %v, got %v", c.Value, c.Options, tag.options)
+		}
+	}
+}
+
+func TestJSONTagOptions(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, JSONStruct{})
+	assert.ExpectField("ID").ExpectJSON().HasKey("id").AsString().OmitEmpty()
+	assert.ExpectField("Name").ExpectJSON().HasKey("name,full").OmitZero().CaseIgnore()
+	assert.ExpectField("Ignored").ExpectJSON().Ignored()
+	assert.ExpectField("Dash").ExpectJSON().HasKey("-")
+	assert.ExpectField("Default").ExpectJSON().HasKey("Default")
+	assert.ExpectField("Created").ExpectJSON().Format("RFC3339")
+	assert.ExpectField("Extra").ExpectJSON().Unknown()
+	assert.ExpectField("Inner").ExpectJSON().Inline()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have an option <%s>", "JSONStruct.ID", "json", "omitzero")
+	assert.ExpectField("ID").ExpectJSON().OmitZero()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is not ignored,but actual <%s>", "JSONStruct.Dash", "json", "-,")
+	assert.ExpectField("Dash").ExpectJSON().Ignored()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a key of <%s>,but actual <%s>", "JSONStruct.ID", "json", "ID", "id")
+	assert.ExpectField("ID").ExpectJSON().HasKey("ID")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a format of <%s>,but actual <%s>", "JSONStruct.Created", "json", "DateOnly", "RFC3339")
+	assert.ExpectField("Created").ExpectJSON().Format("DateOnly")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have an option <%s>", "JSONStruct.ID", "json", "format:sec")
+	assert.ExpectField("ID").ExpectJSON().Format("sec")
+}
+
+func TestJSONTagValid(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, JSONStruct{})
+	for _, name := range []string{"ID", "Name", "Ignored", "Dash", "Default", "Created", "Timeout", "Data", "Extra", "Inner"} {
+		assert.ExpectField(name).ExpectJSON().Valid()
+	}
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> option <string> is not applicable to type <%s>", "JSONStruct.Flags", "json", "[]bool")
+	assert.ExpectField("Flags").ExpectJSON().Valid()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> option <format:hex> is not applicable to type <%s>", "JSONStruct.Raw", "json", "[]int")
+	assert.ExpectField("Raw").ExpectJSON().Valid()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> has conflicting options <case:ignore> and <case:strict>", "JSONStruct.Both", "json")
+	assert.ExpectField("Both").ExpectJSON().Valid()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> has a duplicate option <%s>", "JSONStruct.Wrong", "json", "omitempty")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> has an unknown option <%s>", "JSONStruct.Wrong", "json", "unknownoption")
+	assert.ExpectField("Wrong").ExpectJSON().Valid()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is malformed: unterminated quoted name", "JSONStruct.BadQuote", "json")
+	assert.ExpectField("BadQuote").ExpectJSON().Valid()
+}
+
+func TestJSONApplicable(t *testing.T) {
+	var i int
+	cases := []struct {
+		Name   string
+		Result bool
+	}{
+		{"string on *int", jsonStringApplicable(reflect.TypeOf(&i))},
+		{"format nonfinite on float64", jsonFormatApplicable(reflect.TypeOf(1.0), "nonfinite")},
+		{"format emitnull on map", jsonFormatApplicable(reflect.TypeOf(map[string]int{}), "emitnull")},
+		{"format array on [4]byte", jsonFormatApplicable(reflect.TypeOf([4]byte{}), "array")},
+		{"inline on *struct", jsonInlineApplicable(reflect.TypeOf(&SubStruct{}), false)},
+		{"not string on struct", !jsonStringApplicable(reflect.TypeOf(SubStruct{}))},
+		{"not format on time.Time", !jsonFormatApplicable(timeType, "")},
+		{"not format on int", !jsonFormatApplicable(reflect.TypeOf(0), "hex")},
+		{"not unknown on struct", !jsonInlineApplicable(reflect.TypeOf(SubStruct{}), true)},
+		{"not inline on map[int]", !jsonInlineApplicable(reflect.TypeOf(map[int]string{}), false)},
+	}
+	for _, c := range cases {
+		if !c.Result {
+			t.Errorf("Unexpected result: %s", c.Name)
+		}
+	}
+}
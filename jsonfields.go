@@ -0,0 +1,279 @@
+//jsonFields, dominantJSONField and isValidJSONName are adapted from typeFields,
+//dominantField and isValidTag of encoding/json (src/encoding/json/encode.go).
+//
+//Copyright 2010 The Go Authors. All rights reserved.
+//
+//Redistribution and use in source and binary forms, with or without
+//modification, are permitted provided that the following conditions are
+//met:
+//
+//   * Redistributions of source code must retain the above copyright
+//notice, this list of conditions and the following disclaimer.
+//   * Redistributions in binary form must reproduce the above
+//copyright notice, this list of conditions and the following disclaimer
+//in the documentation and/or other materials provided with the
+//distribution.
+//   * Neither the name of Google LLC nor the names of its
+//contributors may be used to endorse or promote products derived from
+//this software without specific prior written permission.
+//
+//THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+//"AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+//LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+//A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+//OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+//SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+//LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+//DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+//THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+//(INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package assert
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+//jsonField is a field of the structure serialized by encoding/json
+type jsonField struct {
+	name   string
+	path   string
+	tagged bool
+	index  []int
+	typ    reflect.Type
+	field  reflect.StructField
+}
+
+//jsonFields returns the fields serialized by encoding/json for the struct type.
+//It follows the rules of encoding/json: untagged embedded structs are inlined,
+//the dominant field wins by depth and by tag and ambiguous fields are dropped.
+//Adapted from typeFields of encoding/json, see the license notice at the top of the file
+func jsonFields(t reflect.Type) []jsonField {
+	var current []jsonField
+	next := []jsonField{{typ: t}}
+
+	var count, nextCount map[reflect.Type]int
+	visited := make(map[reflect.Type]bool)
+
+	var fields []jsonField
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count, nextCount = nextCount, make(map[reflect.Type]int)
+
+		for _, f := range current {
+			if visited[f.typ] {
+				continue
+			}
+			visited[f.typ] = true
+
+			for i := 0; i < f.typ.NumField(); i++ {
+				sf := f.typ.Field(i)
+				if sf.Anonymous {
+					t := sf.Type
+					if t.Kind() == reflect.Ptr {
+						t = t.Elem()
+					}
+					if sf.PkgPath != "" && t.Kind() != reflect.Struct {
+						continue
+					}
+				} else if sf.PkgPath != "" {
+					continue
+				}
+				tag := sf.Tag.Get(jsonKey)
+				if tag == "-" {
+					continue
+				}
+				name, _ := parseTagValue(tag)
+				if !isValidJSONName(name) {
+					name = ""
+				}
+				index := make([]int, len(f.index)+1)
+				copy(index, f.index)
+				index[len(f.index)] = i
+
+				path := sf.Name
+				if f.path != "" {
+					path = f.path + "." + sf.Name
+				}
+
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+
+				if name != "" || !sf.Anonymous || ft.Kind() != reflect.Struct {
+					tagged := name != ""
+					if name == "" {
+						name = sf.Name
+					}
+					fields = append(fields, jsonField{
+						name:   name,
+						path:   path,
+						tagged: tagged,
+						index:  index,
+						typ:    ft,
+						field:  sf,
+					})
+					if count[f.typ] > 1 {
+						//the same type is embedded twice at the same level,
+						//the duplicate annihilates the field below
+						fields = append(fields, fields[len(fields)-1])
+					}
+					continue
+				}
+
+				nextCount[ft]++
+				if nextCount[ft] == 1 {
+					next = append(next, jsonField{name: ft.Name(), path: path, index: index, typ: ft})
+				}
+			}
+		}
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		x := fields
+		if x[i].name != x[j].name {
+			return x[i].name < x[j].name
+		}
+		if len(x[i].index) != len(x[j].index) {
+			return len(x[i].index) < len(x[j].index)
+		}
+		if x[i].tagged != x[j].tagged {
+			return x[i].tagged
+		}
+		return lessIndex(x[i].index, x[j].index)
+	})
+
+	out := fields[:0]
+	for advance, i := 0, 0; i < len(fields); i += advance {
+		fi := fields[i]
+		for advance = 1; i+advance < len(fields); advance++ {
+			if fields[i+advance].name != fi.name {
+				break
+			}
+		}
+		if advance == 1 {
+			out = append(out, fi)
+			continue
+		}
+		if dominant, ok := dominantJSONField(fields[i : i+advance]); ok {
+			out = append(out, dominant)
+		}
+	}
+	fields = out
+
+	sort.Slice(fields, func(i, j int) bool {
+		return lessIndex(fields[i].index, fields[j].index)
+	})
+	return fields
+}
+
+//dominantJSONField returns the dominant field among the fields with the same name sorted by depth and tag.
+//Adapted from dominantField of encoding/json
+func dominantJSONField(fields []jsonField) (jsonField, bool) {
+	if len(fields) > 1 && len(fields[0].index) == len(fields[1].index) && fields[0].tagged == fields[1].tagged {
+		return jsonField{}, false
+	}
+	return fields[0], true
+}
+
+func lessIndex(a, b []int) bool {
+	for k, x := range a {
+		if k >= len(b) {
+			return false
+		}
+		if x != b[k] {
+			return x < b[k]
+		}
+	}
+	return len(a) < len(b)
+}
+
+//isValidJSONName reports whether encoding/json accepts the name from the tag.
+//Adapted from isValidTag of encoding/json
+func isValidJSONName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", c):
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			return false
+		}
+	}
+	return true
+}
+
+//JSONKeys returns the keys of the JSON object produced by encoding/json for the structure
+func (a *StructAssert) JSONKeys() []string {
+	if a.failed {
+		return nil
+	}
+	fields := jsonFields(a.structType())
+	keys := make([]string, 0, len(fields))
+	for _, f := range fields {
+		keys = append(keys, f.name)
+	}
+	return keys
+}
+
+//ExpectJSONKeys checks that encoding/json serializes the structure with exactly the specified keys in any order
+func (a *StructAssert) ExpectJSONKeys(keys ...string) *StructAssert {
+	a.t.Helper()
+	if a.failed {
+		return a
+	}
+	actual := make(map[string]bool)
+	for _, key := range a.JSONKeys() {
+		actual[key] = true
+	}
+	expected := make(map[string]bool)
+	for _, key := range keys {
+		expected[key] = true
+		if !actual[key] {
+			a.fail(Failure{Field: a.structName(), Problem: "JSON key <" + key + "> not found"})
+		}
+	}
+	for _, key := range a.JSONKeys() {
+		if !expected[key] {
+			a.fail(Failure{Field: a.structName(), Problem: "JSON key <" + key + "> is unexpected"})
+		}
+	}
+	return a
+}
+
+//HasJSONKey checks that encoding/json serializes the structure with the key
+func (a *StructAssert) HasJSONKey(key string) *StructAssert {
+	a.t.Helper()
+	a.ExpectJSONField(key)
+	return a
+}
+
+//ExpectJSONField waiting for the field serialized by encoding/json with the key to verify assert.
+//The field may be promoted from an embedded structure
+func (a *StructAssert) ExpectJSONField(key string) *Field {
+	a.t.Helper()
+	if !a.failed {
+		for _, f := range jsonFields(a.structType()) {
+			if f.name == key {
+				structField := f.field
+				return &Field{
+					name:        f.path,
+					structField: &structField,
+					assert:      a,
+				}
+			}
+		}
+		a.fail(Failure{Field: a.structName(), Problem: "JSON key <" + key + "> not found"})
+	}
+	return &Field{
+		name:     key,
+		assert:   a,
+		notFound: true,
+	}
+}
@@ -0,0 +1,115 @@
+package assert
+
+import (
+	"reflect"
+	"testing"
+)
+
+//nolint
+type (
+	JSONBase struct {
+		ID      int    `json:"id"`
+		Name    string `json:"name"`
+		Created string
+	}
+	JSONAudit struct {
+		Name    string `json:"name"`
+		Updated string `json:"updated"`
+		Created string
+	}
+	JSONTagged struct {
+		Name string
+	}
+	jsonHidden struct {
+		Secret string `json:"secret"`
+	}
+	JSONEntity struct {
+		JSONBase
+		*JSONAudit
+		JSONTagged `json:"tagged"`
+		jsonHidden
+		ID    string `json:"-"`
+		Title string `json:"title,omitempty"`
+	}
+)
+
+func TestJSONKeys(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, JSONEntity{})
+	expected := []string{"id", "updated", "tagged", "secret", "title"}
+	if keys := assert.JSONKeys(); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected %v, got %v", expected, keys)
+	}
+
+	assert.ExpectJSONKeys("title", "id", "updated", "tagged", "secret").
+		HasJSONKey("id")
+
+	test.mockT.EXPECT().Errorf("%s: JSON key <name> not found", "JSONEntity")
+	test.mockT.EXPECT().Errorf("%s: JSON key <secret> is unexpected", "JSONEntity")
+	assert.ExpectJSONKeys("title", "id", "updated", "tagged", "name")
+
+	test.mockT.EXPECT().Errorf("%s: JSON key <Created> not found", "JSONEntity")
+	assert.HasJSONKey("Created")
+}
+
+func TestExpectJSONField(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, &JSONEntity{})
+	field := assert.ExpectJSONField("id")
+	if field.name != "JSONBase.ID" {
+		t.Errorf("Expected %q, got %q", "JSONBase.ID", field.name)
+	}
+	field.Assert("json", "id")
+	assert.ExpectJSONField("updated").Assert("json", "updated")
+	assert.ExpectJSONField("secret").Assert("json", "secret")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a value of <%s>,but actual <%s>", "JSONEntity.JSONAudit.Updated", "json", "modified", "updated")
+	assert.ExpectJSONField("updated").Assert("json", "modified")
+
+	test.mockT.EXPECT().Errorf("%s: JSON key <name> not found", "JSONEntity")
+	field = assert.ExpectJSONField("name")
+	if field.structField != nil {
+		t.Errorf("Expected nil, got %v", field.structField)
+	}
+}
+
+func TestJSONFieldsDuplicateEmbedding(t *testing.T) {
+	type A struct{ X int }
+	type B struct{ A }
+	type C struct{ A }
+	type D struct {
+		B
+		C
+		Y int
+	}
+	fields := jsonFields(reflect.TypeOf(D{}))
+	if len(fields) != 1 || fields[0].name != "Y" {
+		t.Errorf("Expected only Y, got %v", fields)
+	}
+}
+
+func TestIsValidJSONName(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"":       false,
+		"id":     true,
+		"a-b.c":  true,
+		"имя":    true,
+		`a"b`:    false,
+		`a\b`:    false,
+		"a b":    true,
+		"a,b":    false,
+		"#tag1$": true,
+	} {
+		if isValidJSONName(name) != valid {
+			t.Errorf("%q: expected %v", name, valid)
+		}
+	}
+}
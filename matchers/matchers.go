@@ -0,0 +1,106 @@
+//Package matchers provides the Gomega matchers for the tags of the structures
+package matchers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	assert "github.com/arteev/tag-assert"
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/types"
+)
+
+//structMatcher runs the assertions of the rule on the actual structure or the type of the structure
+type structMatcher struct {
+	name     string
+	message  string
+	expected interface{}
+	rule     assert.Rule
+	failures []error
+}
+
+//Match runs the rule, the actual value must be a structure, a pointer to it or its reflect.Type
+func (m *structMatcher) Match(actual interface{}) (bool, error) {
+	var errs []error
+	if vtype, ok := actual.(reflect.Type); ok {
+		errs = assert.CheckReflectType(vtype, m.rule)
+	} else {
+		errs = assert.Check(actual, m.rule)
+	}
+	for _, err := range errs {
+		if errors.Is(err, assert.ErrNotStruct) || errors.Is(err, assert.ErrUnxpectedNil) {
+			return false, fmt.Errorf("%s matcher expects a struct, a pointer to a struct or its reflect.Type.  Got:\n%s",
+				m.name, format.Object(actual, 1))
+		}
+	}
+	m.failures = errs
+	return len(errs) == 0, nil
+}
+
+//FailureMessage returns the message of the failed match with the failed assertions
+func (m *structMatcher) FailureMessage(actual interface{}) string {
+	message := format.Message(actual, m.message, m.expected)
+	if len(m.failures) == 0 {
+		return message
+	}
+	reasons := make([]string, 0, len(m.failures))
+	for _, err := range m.failures {
+		reasons = append(reasons, format.Indent+err.Error())
+	}
+	return message + "\nbut\n" + strings.Join(reasons, "\n")
+}
+
+//NegatedFailureMessage returns the message of the failed negated match
+func (m *structMatcher) NegatedFailureMessage(actual interface{}) string {
+	return format.Message(actual, "not "+m.message, m.expected)
+}
+
+//HaveField succeeds if the structure has the field, the name may be a path: "Items[].ID"
+func HaveField(name string) types.GomegaMatcher {
+	return &structMatcher{
+		name:     "HaveField",
+		message:  "to have field",
+		expected: name,
+		rule: func(a *assert.StructAssert) {
+			a.HasField(name)
+		},
+	}
+}
+
+//HaveTag succeeds if the field of the structure has the tag with the value
+func HaveTag(field, tag, value string) types.GomegaMatcher {
+	return &structMatcher{
+		name:     "HaveTag",
+		message:  fmt.Sprintf("to have field <%s> with tag <%s> of", field, tag),
+		expected: value,
+		rule: func(a *assert.StructAssert) {
+			a.ExpectField(field).Assert(tag, value)
+		},
+	}
+}
+
+//HaveTagOption succeeds if the tag of the field of the structure has the option
+func HaveTagOption(field, tag, option string) types.GomegaMatcher {
+	return &structMatcher{
+		name:     "HaveTagOption",
+		message:  fmt.Sprintf("to have field <%s> with tag <%s> having option", field, tag),
+		expected: option,
+		rule: func(a *assert.StructAssert) {
+			a.ExpectField(field).ExpectTag(tag).HasOption(option)
+		},
+	}
+}
+
+//HaveUniqueTagValues succeeds if the names in the tag are unique among the fields of the structure
+func HaveUniqueTagValues(tag string) types.GomegaMatcher {
+	return &structMatcher{
+		name:     "HaveUniqueTagValues",
+		message:  "to have unique values of tag",
+		expected: tag,
+		rule: func(a *assert.StructAssert) {
+			a.UniqueTagValues(tag)
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package matchers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+//nolint
+type (
+	User struct {
+		ID      int      `json:"id" db:"id"`
+		Name    string   `json:"name,omitempty" db:"name"`
+		Email   string   `json:"email" db:"name"`
+		Address *Address `json:"address"`
+	}
+	Address struct {
+		City string `json:"city"`
+	}
+)
+
+func TestMatchers(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	g.Expect(User{}).To(HaveField("Name"))
+	g.Expect(&User{}).To(HaveField("Address.City"))
+	g.Expect(reflect.TypeOf(User{})).To(HaveField("Email"))
+	g.Expect(User{}).NotTo(HaveField("Password"))
+
+	g.Expect(User{}).To(HaveTag("Name", "json", "name,omitempty"))
+	g.Expect(User{}).To(HaveTag("Address.City", "json", "city"))
+	g.Expect(User{}).NotTo(HaveTag("Name", "json", "name"))
+	g.Expect(User{}).NotTo(HaveTag("Name", "xml", "name"))
+
+	g.Expect(User{}).To(HaveTagOption("Name", "json", "omitempty"))
+	g.Expect(User{}).NotTo(HaveTagOption("Email", "json", "omitempty"))
+
+	g.Expect(User{}).To(HaveUniqueTagValues("json"))
+	g.Expect(User{}).NotTo(HaveUniqueTagValues("db"))
+}
+
+func TestFailureMessage(t *testing.T) {
+	matcher := HaveTag("Name", "json", "name")
+	ok, err := matcher.Match(User{})
+	if ok || err != nil {
+		t.Fatalf("Expected no match, got %v, %v", ok, err)
+	}
+	message := matcher.FailureMessage(User{})
+	if !strings.HasPrefix(message, "Expected\n    <matchers.User>: {") {
+		t.Errorf("Unexpected message %q", message)
+	}
+	expected := "to have field <Name> with tag <json> of\n    <string>: name\n" +
+		"but\n    User.Name: Tag <json> does not have a value of <name>,but actual <name,omitempty>"
+	if !strings.HasSuffix(message, expected) {
+		t.Errorf("Expected the suffix %q, got %q", expected, message)
+	}
+
+	matcher = HaveField("Password")
+	if ok, _ := matcher.Match(User{}); ok {
+		t.Fatal("Expected no match")
+	}
+	if message := matcher.FailureMessage(User{}); !strings.HasSuffix(message, "but\n    User: Field <Password> not found") {
+		t.Errorf("Unexpected message %q", message)
+	}
+
+	matcher = HaveUniqueTagValues("json")
+	if ok, _ := matcher.Match(User{}); !ok {
+		t.Fatal("Expected match")
+	}
+	if message := matcher.NegatedFailureMessage(User{}); !strings.HasSuffix(message, "not to have unique values of tag\n    <string>: json") {
+		t.Errorf("Unexpected message %q", message)
+	}
+}
+
+func TestMatchNotStruct(t *testing.T) {
+	for _, actual := range []interface{}{nil, 1, reflect.TypeOf("")} {
+		ok, err := HaveField("Name").Match(actual)
+		if ok || err == nil || !strings.HasPrefix(err.Error(), "HaveField matcher expects a struct") {
+			t.Errorf("%v: expected an error, got %v, %v", actual, ok, err)
+		}
+	}
+}
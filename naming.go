@@ -0,0 +1,134 @@
+package assert
+
+import (
+	"strings"
+	"unicode"
+)
+
+//NamingStrategy converts the name of a field to the name expected in a tag
+type NamingStrategy func(name string) string
+
+//splitWords splits the name of a field into words: "HTTPServerID" -> "HTTP", "Server", "ID"
+func splitWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == '_' || r == '-' || unicode.IsSpace(r) {
+			if start < i {
+				words = append(words, string(runes[start:i]))
+			}
+			start = i + 1
+			continue
+		}
+		if i == start || !unicode.IsUpper(r) {
+			continue
+		}
+		prev := runes[i-1]
+		nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if !unicode.IsUpper(prev) || nextLower {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+func capitalize(word string) string {
+	runes := []rune(strings.ToLower(word))
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
+
+func joinWords(name, sep string, convert func(i int, word string) string) string {
+	words := splitWords(name)
+	for i, word := range words {
+		words[i] = convert(i, word)
+	}
+	return strings.Join(words, sep)
+}
+
+//SnakeCase converts the name to snake_case: "UserID" -> "user_id"
+func SnakeCase(name string) string {
+	return joinWords(name, "_", func(_ int, word string) string {
+		return strings.ToLower(word)
+	})
+}
+
+//ScreamingSnakeCase converts the name to SCREAMING_SNAKE_CASE: "UserID" -> "USER_ID"
+func ScreamingSnakeCase(name string) string {
+	return joinWords(name, "_", func(_ int, word string) string {
+		return strings.ToUpper(word)
+	})
+}
+
+//KebabCase converts the name to kebab-case: "UserID" -> "user-id"
+func KebabCase(name string) string {
+	return joinWords(name, "-", func(_ int, word string) string {
+		return strings.ToLower(word)
+	})
+}
+
+//CamelCase converts the name to camelCase: "UserID" -> "userId"
+func CamelCase(name string) string {
+	return joinWords(name, "", func(i int, word string) string {
+		if i == 0 {
+			return strings.ToLower(word)
+		}
+		return capitalize(word)
+	})
+}
+
+//PascalCase converts the name to PascalCase: "user_id" -> "UserId"
+func PascalCase(name string) string {
+	return joinWords(name, "", func(_ int, word string) string {
+		return capitalize(word)
+	})
+}
+
+//LowerCase converts the name to lower case: "UserID" -> "userid"
+func LowerCase(name string) string {
+	return strings.ToLower(name)
+}
+
+//WithPrefix adds the prefix to the name produced by the strategy
+func WithPrefix(prefix string, strategy NamingStrategy) NamingStrategy {
+	return func(name string) string {
+		return prefix + strategy(name)
+	}
+}
+
+//Naming checks that the name of the tag of every field in the selection is produced by the strategy
+//from the name of the field. The fields without the tag, ignored (-) and the exceptions are skipped
+func (s *Selection) Naming(name string, strategy NamingStrategy, except ...string) *Selection {
+	s.assert.t.Helper()
+	skip := make(map[string]bool)
+	for _, field := range except {
+		skip[field] = true
+	}
+	for _, field := range s.fields {
+		if skip[field.name] {
+			continue
+		}
+		value, ok := field.structField.Tag.Lookup(name)
+		if !ok || value == "-" {
+			continue
+		}
+		field.ExpectTag(name).HasName(strategy(field.structField.Name))
+	}
+	return s
+}
+
+//Naming checks the naming strategy of the tag for all exported fields of the structure
+//or for all fields WithUnexported
+func (a *StructAssert) Naming(name string, strategy NamingStrategy, except ...string) *StructAssert {
+	a.t.Helper()
+	a.visibleFields().Naming(name, strategy, except...)
+	return a
+}
@@ -0,0 +1,73 @@
+package assert
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitWords(t *testing.T) {
+	cases := map[string][]string{
+		"Name":         {"Name"},
+		"UserID":       {"User", "ID"},
+		"HTTPServerID": {"HTTP", "Server", "ID"},
+		"userName":     {"user", "Name"},
+		"Address2":     {"Address2"},
+		"user_name":    {"user", "name"},
+		"ID":           {"ID"},
+		"":             nil,
+	}
+	for name, expected := range cases {
+		if words := splitWords(name); !reflect.DeepEqual(words, expected) {
+			t.Errorf("%q: expected %v, got %v", name, expected, words)
+		}
+	}
+}
+
+func TestNamingStrategies(t *testing.T) {
+	cases := []struct {
+		Strategy NamingStrategy
+		Name     string
+		Expected string
+	}{
+		{SnakeCase, "HTTPServerID", "http_server_id"},
+		{ScreamingSnakeCase, "UserName", "USER_NAME"},
+		{KebabCase, "UserName", "user-name"},
+		{CamelCase, "UserID", "userId"},
+		{PascalCase, "user_id", "UserId"},
+		{LowerCase, "UserID", "userid"},
+		{WithPrefix("user_", SnakeCase), "FirstName", "user_first_name"},
+	}
+	for _, c := range cases {
+		if actual := c.Strategy(c.Name); actual != c.Expected {
+			t.Errorf("%q: expected %q, got %q", c.Name, c.Expected, actual)
+		}
+	}
+}
+
+//nolint
+type NamingStruct struct {
+	UserID    int    `json:"user_id" db:"user_id"`
+	FirstName string `json:"first_name" db:"user_first_name"`
+	LastName  string `json:"surname" db:"user_last_name"`
+	Ignored   string `json:"-"`
+	Untagged  string
+}
+
+func TestNaming(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, NamingStruct{})
+	assert.Naming("json", SnakeCase, "LastName")
+	assert.Fields().NameMatches("Name$").Naming("db", WithPrefix("user_", SnakeCase))
+	assert.Naming("json", NamingStrategy(strings.ToLower), "UserID", "FirstName", "LastName")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a name of <%s>,but actual <%s>", "NamingStruct.LastName", "json", "last_name", "surname")
+	assert.Naming("json", SnakeCase)
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a name of <%s>,but actual <%s>", "NamingStruct.UserID", "db", "user_user_id", "user_id")
+	assert.Naming("db", WithPrefix("user_", SnakeCase))
+}
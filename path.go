@@ -0,0 +1,60 @@
+package assert
+
+import (
+	"reflect"
+	"strings"
+)
+
+//containers in the path of a nested field
+const (
+	pathSlice = "[]"
+	pathMap   = "{}"
+)
+
+//pathSegment is a field name in the path followed by the containers to descend into
+type pathSegment struct {
+	name       string
+	containers []string
+}
+
+//parseFieldPath splits the path of a nested field ("Items[].Meta{}.Owner") into segments
+func parseFieldPath(path string) ([]pathSegment, bool) {
+	var segments []pathSegment
+	for _, part := range strings.Split(path, ".") {
+		segment := pathSegment{}
+		for {
+			if strings.HasSuffix(part, pathSlice) {
+				segment.containers = append([]string{pathSlice}, segment.containers...)
+			} else if strings.HasSuffix(part, pathMap) {
+				segment.containers = append([]string{pathMap}, segment.containers...)
+			} else {
+				break
+			}
+			part = part[:len(part)-2]
+		}
+		if part == "" || strings.ContainsAny(part, "[]{}") {
+			return nil, false
+		}
+		segment.name = part
+		segments = append(segments, segment)
+	}
+	return segments, true
+}
+
+//unwrapType dereferences pointers and descends into the elements of slices, arrays and maps.
+//It returns the innermost type and the containers passed
+func unwrapType(t reflect.Type) (reflect.Type, string) {
+	containers := ""
+	for {
+		switch t.Kind() {
+		case reflect.Ptr:
+		case reflect.Slice, reflect.Array:
+			containers += pathSlice
+		case reflect.Map:
+			containers += pathMap
+		default:
+			return t, containers
+		}
+		t = t.Elem()
+	}
+}
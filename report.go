@@ -0,0 +1,105 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+//Failure describes a failed assertion
+type Failure struct {
+	//Struct is the name of the structure for the failures of its fields found by the structure:
+	//a missing or a private field
+	Struct string
+	//Field is the full name of the field or the name of the structure
+	Field    string
+	Tag      string
+	Expected string
+	Actual   string
+	Problem  string
+}
+
+//format returns the format and the arguments of the message of the failure:
+//"<Field>: Tag <Tag> <Problem> <Expected>,but actual <Actual>" or "<Struct>: Field <name> <Problem>".
+//The empty parts are omitted
+func (f Failure) format() (string, []interface{}) {
+	format, args := "%s: ", []interface{}{f.Field}
+	if f.Struct != "" {
+		args[0] = f.Struct
+		if name := strings.TrimPrefix(f.Field, f.Struct+"."); name != f.Field {
+			format += "Field <%s> "
+			args = append(args, name)
+		}
+	}
+	if f.Tag != "" {
+		format += "Tag <%s> "
+		args = append(args, f.Tag)
+	}
+	format += strings.ReplaceAll(f.Problem, "%", "%%")
+	if f.Expected != "" {
+		format += " <%s>"
+		args = append(args, f.Expected)
+	}
+	if f.Actual != "" {
+		format += ",but actual <%s>"
+		args = append(args, f.Actual)
+	}
+	return format, args
+}
+
+//String returns the message of the failure
+func (f Failure) String() string {
+	format, args := f.format()
+	return fmt.Sprintf(format, args...)
+}
+
+//collector keeps the failures of the structure and of its nested structures
+type collector struct {
+	collect  bool
+	failures []Failure
+	reported int
+}
+
+//cleaner is implemented by testing.T and testing.B
+type cleaner interface {
+	Cleanup(func())
+}
+
+//Collect stores the failures instead of reporting them at once. The grouped report is emitted
+//at the end of the test through Cleanup or by Report
+func Collect() Option {
+	return func(a *StructAssert) {
+		a.collector.collect = true
+		if c, ok := a.t.(cleaner); ok {
+			c.Cleanup(a.Report)
+		}
+	}
+}
+
+//Failures returns the failed assertions of the structure and of its nested structures
+func (a *StructAssert) Failures() []Failure {
+	if a.collector == nil {
+		return nil
+	}
+	return append([]Failure(nil), a.collector.failures...)
+}
+
+//Report emits the table of the failures collected since the previous report
+func (a *StructAssert) Report() {
+	a.t.Helper()
+	if a.collector == nil || a.collector.reported == len(a.collector.failures) {
+		return
+	}
+	failures := a.collector.failures[a.collector.reported:]
+	a.collector.reported = len(a.collector.failures)
+
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FIELD\tTAG\tEXPECTED\tACTUAL\tPROBLEM")
+	for _, f := range failures {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", f.Field, f.Tag, f.Expected, f.Actual, f.Problem)
+	}
+	w.Flush()
+	a.t.Errorf("%s: %d failed assertions\n%s", a.structName(), len(failures), b.String())
+}
+
@@ -0,0 +1,130 @@
+package assert
+
+import (
+	"reflect"
+	"testing"
+)
+
+type cleanupTB struct {
+	*MockTB
+	cleanups []func()
+}
+
+func (c *cleanupTB) Cleanup(fn func()) {
+	c.cleanups = append(c.cleanups, fn)
+}
+
+func TestFailureString(t *testing.T) {
+	cases := []struct {
+		Failure  Failure
+		Expected string
+	}{
+		{
+			Failure{Field: "TestStruct.Public", Tag: "tag1", Expected: "public", Actual: "pub", Problem: "does not have a value of"},
+			"TestStruct.Public: Tag <tag1> does not have a value of <public>,but actual <pub>",
+		},
+		{
+			Failure{Struct: "TestStruct", Field: "TestStruct.SN", Problem: "not found"},
+			"TestStruct: Field <SN> not found",
+		},
+		{
+			Failure{Field: "TestStruct.Public", Tag: "json", Expected: "omitempty", Problem: "does not have an option"},
+			"TestStruct.Public: Tag <json> does not have an option <omitempty>",
+		},
+		{
+			Failure{Field: "TestStruct.Public", Actual: "int", Problem: "Field is not a pointer"},
+			"TestStruct.Public: Field is not a pointer,but actual <int>",
+		},
+		{
+			Failure{Field: "TestStruct.Public", Problem: "Tag is malformed: 100% bad"},
+			"TestStruct.Public: Tag is malformed: 100% bad",
+		},
+	}
+	for _, c := range cases {
+		if actual := c.Failure.String(); actual != c.Expected {
+			t.Errorf("Expected %q, got %q", c.Expected, actual)
+		}
+	}
+}
+
+func TestCollect(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+	mockT := &cleanupTB{MockTB: test.mockT}
+
+	assert := Expect(mockT, TestStruct{}, Collect())
+	assert.ExpectField("Public").Assert("tag1", "public").HasTag("json")
+	assert.ExpectField("SN").Assert("json", "sn")
+	assert.ExpectField("SubStruct").Struct().HasField("Missing")
+
+	expected := []Failure{
+		{Field: "TestStruct.Public", Tag: "tag1", Expected: "public", Actual: "pub", Problem: "does not have a value of"},
+		{Field: "TestStruct.Public", Tag: "json", Problem: "not found"},
+		{Struct: "TestStruct", Field: "TestStruct.SN", Problem: "not found"},
+		{Struct: "TestStruct.SubStruct", Field: "TestStruct.SubStruct.Missing", Problem: "not found"},
+	}
+	if failures := assert.Failures(); !reflect.DeepEqual(failures, expected) {
+		t.Errorf("Expected %v, got %v", expected, failures)
+	}
+	if len(mockT.cleanups) != 1 {
+		t.Fatalf("Expected one cleanup, got %d", len(mockT.cleanups))
+	}
+
+	test.mockT.EXPECT().Errorf("%s: %d failed assertions\n%s", "TestStruct", 4,
+		"FIELD                         TAG   EXPECTED  ACTUAL  PROBLEM\n"+
+			"TestStruct.Public             tag1  public    pub     does not have a value of\n"+
+			"TestStruct.Public             json                    not found\n"+
+			"TestStruct.SN                                         not found\n"+
+			"TestStruct.SubStruct.Missing                          not found\n")
+	mockT.cleanups[0]()
+
+	//the failures are reported once
+	assert.Report()
+
+	test.mockT.EXPECT().Errorf("%s: %d failed assertions\n%s", "TestStruct", 1,
+		"FIELD          TAG  EXPECTED  ACTUAL  PROBLEM\n"+
+			"TestStruct.ID                         not found\n")
+	assert.HasField("ID").Report()
+}
+
+func TestFailures(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, TestStruct{})
+	assert.ExpectField("Public").Assert("tag1", "pub")
+	if failures := assert.Failures(); len(failures) != 0 {
+		t.Errorf("Unexpected failures %v", failures)
+	}
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "TestStruct.Public", "json")
+	assert.ExpectField("Public").HasTag("json")
+	expected := []Failure{{Field: "TestStruct.Public", Tag: "json", Problem: "not found"}}
+	if failures := assert.Failures(); !reflect.DeepEqual(failures, expected) {
+		t.Errorf("Expected %v, got %v", expected, failures)
+	}
+}
+
+func TestReportOfFailedStruct(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	test.mockT.EXPECT().Errorf("%s: %d failed assertions\n%s", "TestStruct.Missing", 1,
+		"FIELD               TAG  EXPECTED  ACTUAL  PROBLEM\n"+
+			"TestStruct.Missing                         not found\n")
+	Expect(test.t, TestStruct{}, Collect()).ExpectField("Missing").Struct().Report()
+
+	test.mockT.EXPECT().Errorf("%s: %d failed assertions\n%s", "TestStruct.Public", 1,
+		"FIELD              TAG  EXPECTED  ACTUAL  PROBLEM\n"+
+			"TestStruct.Public                         is not a struct\n")
+	Expect(test.t, TestStruct{}, Collect()).ExpectField("Public").Struct().Report()
+
+	test.mockT.EXPECT().Fatal(ErrUnxpectedNil)
+	Expect(test.t, nil, Collect()).Report()
+}
@@ -0,0 +1,110 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+)
+
+//Reporter receives the failed assertions, it is the minimal part of testing.TB used by the assertions
+type Reporter interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	FailNow()
+}
+
+//TB is the interface common to testing.T and testing.B
+type TB interface {
+	Error(args ...interface{})
+	Errorf(format string, args ...interface{})
+	Fail()
+	FailNow()
+	Failed() bool
+	Fatal(args ...interface{})
+	Fatalf(format string, args ...interface{})
+	Log(args ...interface{})
+	Logf(format string, args ...interface{})
+	Name() string
+	Skip(args ...interface{})
+	SkipNow()
+	Skipf(format string, args ...interface{})
+	Skipped() bool
+	Helper()
+}
+
+//fataler is implemented by the Reporter stopping the test with the error
+type fataler interface {
+	Fatal(args ...interface{})
+}
+
+//namer is implemented by the Reporter knowing the name of the test
+type namer interface {
+	Name() string
+}
+
+//failureReporter is implemented by the Reporter keeping the failures as they are
+type failureReporter interface {
+	reportFailure(failure Failure, message string)
+}
+
+//ErrorCollector is a Reporter collecting the failed assertions as errors.
+//After FailNow the following failures are ignored
+type ErrorCollector struct {
+	errs    []error
+	stopped bool
+}
+
+//NewErrorCollector returns an empty ErrorCollector
+func NewErrorCollector() *ErrorCollector {
+	return &ErrorCollector{}
+}
+
+//Helper does nothing
+func (c *ErrorCollector) Helper() {}
+
+//Errorf collects the message as an error
+func (c *ErrorCollector) Errorf(format string, args ...interface{}) {
+	c.add(fmt.Errorf(format, args...))
+}
+
+//reportFailure collects the failed assertion as *Error
+func (c *ErrorCollector) reportFailure(failure Failure, message string) {
+	c.add(&Error{Failure: failure, message: message})
+}
+
+//Fatal collects the error of the argument: ErrNotStruct or ErrUnxpectedNil
+func (c *ErrorCollector) Fatal(args ...interface{}) {
+	c.add(errorOf(args...))
+	c.FailNow()
+}
+
+//FailNow stops collecting the failures
+func (c *ErrorCollector) FailNow() {
+	c.stopped = true
+}
+
+//Errors returns the collected errors
+func (c *ErrorCollector) Errors() []error {
+	return append([]error(nil), c.errs...)
+}
+
+//Err returns the collected errors joined into one error or nil
+func (c *ErrorCollector) Err() error {
+	return errors.Join(c.errs...)
+}
+
+func (c *ErrorCollector) add(err error) {
+	if !c.stopped {
+		c.errs = append(c.errs, err)
+	}
+}
+
+//errorOf returns the only error argument as is: Fatal(ErrNotStruct)
+func errorOf(args ...interface{}) error {
+	if len(args) == 1 {
+		if err, ok := args[0].(error); ok {
+			return err
+		}
+	}
+	message := fmt.Sprint(args...)
+	return &Error{Failure: Failure{Problem: message}, message: message}
+}
@@ -0,0 +1,92 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+//messageReporter is a Reporter without Fatal and Name
+type messageReporter struct {
+	messages []string
+	stopped  int
+}
+
+func (r *messageReporter) Helper() {}
+
+func (r *messageReporter) Errorf(format string, args ...interface{}) {
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+}
+
+func (r *messageReporter) FailNow() {
+	r.stopped++
+}
+
+func TestReporter(t *testing.T) {
+	r := &messageReporter{}
+	Expect(r, TestStruct{}).ExpectField("Public").Assert("tag1", "public")
+	Expect(r, 1)
+
+	expected := []string{
+		"TestStruct.Public: Tag <tag1> does not have a value of <public>,but actual <pub>",
+		ErrNotStruct.Error(),
+	}
+	if fmt.Sprint(r.messages) != fmt.Sprint(expected) {
+		t.Errorf("Expected %q, got %q", expected, r.messages)
+	}
+	if r.stopped != 1 {
+		t.Errorf("Expected one FailNow, got %d", r.stopped)
+	}
+
+	r = &messageReporter{}
+	Snapshot(r, TestStruct{})
+	path := filepath.Join(snapshotDir, "TestStruct.tags")
+	expected = []string{fmt.Sprintf("TestStruct: Snapshot <%s> not found, run the test with %s=1", path, updateEnv)}
+	if fmt.Sprint(r.messages) != fmt.Sprint(expected) {
+		t.Errorf("Expected %q, got %q", expected, r.messages)
+	}
+}
+
+func TestTestingReporter(t *testing.T) {
+	Expect(t, &TestStruct{}).
+		ExpectField("Public").
+		Assert("tag1", "pub").
+		Assert("tag2", "public,options")
+}
+
+func TestErrorCollector(t *testing.T) {
+	c := NewErrorCollector()
+	Expect(c, TestStruct{}).
+		HasField("SN").
+		ExpectField("Public").Assert("tag1", "public")
+
+	errs := c.Errors()
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 errors, got %v", errs)
+	}
+	var err *Error
+	if !errors.As(errs[1], &err) || err.Tag != "tag1" || err.Actual != "pub" {
+		t.Errorf("Unexpected error %#v", errs[1])
+	}
+	expected := "TestStruct: Field <SN> not found\n" +
+		"TestStruct.Public: Tag <tag1> does not have a value of <public>,but actual <pub>"
+	if c.Err() == nil || c.Err().Error() != expected {
+		t.Errorf("Expected %q, got %v", expected, c.Err())
+	}
+
+	c = NewErrorCollector()
+	if c.Err() != nil {
+		t.Errorf("Unexpected error %v", c.Err())
+	}
+	Require(c, TestStruct{}).HasField("SN").HasField("ID")
+	if errs := c.Errors(); len(errs) != 1 {
+		t.Errorf("Expected one error, got %v", errs)
+	}
+
+	c = NewErrorCollector()
+	Expect(c, nil).HasField("ID")
+	if errs := c.Errors(); len(errs) != 1 || !errors.Is(errs[0], ErrUnxpectedNil) {
+		t.Errorf("Expected %v, got %v", ErrUnxpectedNil, errs)
+	}
+}
@@ -0,0 +1,164 @@
+package assert
+
+import (
+	"reflect"
+	"regexp"
+)
+
+//Selection contains methods for verifying a set of fields of the structure
+type Selection struct {
+	assert *StructAssert
+	fields []*Field
+}
+
+//Fields returns the selection of the fields of the structure in the order of declaration:
+//the exported fields or all fields WithUnexported
+func (a *StructAssert) Fields() *Selection {
+	return a.visibleFields()
+}
+
+//visibleFields returns the selection of the fields available for the assertions,
+//every bulk assertion iterates over it
+func (a *StructAssert) visibleFields() *Selection {
+	selection := &Selection{assert: a}
+	if a.failed {
+		return selection
+	}
+	vtype := a.structType()
+	for i := 0; i < vtype.NumField(); i++ {
+		structField := vtype.Field(i)
+		if structField.PkgPath != "" && !a.unexported {
+			continue
+		}
+		selection.fields = append(selection.fields, &Field{
+			name:        structField.Name,
+			structField: &structField,
+			assert:      a,
+		})
+	}
+	return selection
+}
+
+//EachField calls fn for every field of the structure
+func (a *StructAssert) EachField(fn func(*Field)) *StructAssert {
+	a.visibleFields().Each(fn)
+	return a
+}
+
+//Each calls fn for every field of the selection
+func (s *Selection) Each(fn func(*Field)) *Selection {
+	for _, field := range s.fields {
+		fn(field)
+	}
+	return s
+}
+
+//Len returns the number of fields in the selection
+func (s *Selection) Len() int {
+	return len(s.fields)
+}
+
+//Names returns the names of fields in the selection
+func (s *Selection) Names() []string {
+	names := make([]string, 0, len(s.fields))
+	for _, field := range s.fields {
+		names = append(names, field.name)
+	}
+	return names
+}
+
+//Filter returns the selection of fields for which fn returns true
+func (s *Selection) Filter(fn func(*Field) bool) *Selection {
+	selection := &Selection{assert: s.assert}
+	for _, field := range s.fields {
+		if fn(field) {
+			selection.fields = append(selection.fields, field)
+		}
+	}
+	return selection
+}
+
+//ByKind returns the selection of fields with any of the kinds
+func (s *Selection) ByKind(kinds ...reflect.Kind) *Selection {
+	return s.Filter(func(f *Field) bool {
+		for _, kind := range kinds {
+			if f.structField.Type.Kind() == kind {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+//ByType returns the selection of fields with the type of v
+func (s *Selection) ByType(v interface{}) *Selection {
+	vtype := reflect.TypeOf(v)
+	return s.Filter(func(f *Field) bool {
+		return f.structField.Type == vtype
+	})
+}
+
+//Exported returns the selection of exported fields
+func (s *Selection) Exported() *Selection {
+	return s.Filter(func(f *Field) bool {
+		return f.structField.PkgPath == ""
+	})
+}
+
+//Unexported returns the selection of unexported fields
+func (s *Selection) Unexported() *Selection {
+	return s.Filter(func(f *Field) bool {
+		return f.structField.PkgPath != ""
+	})
+}
+
+//WithTag returns the selection of fields having the tag
+func (s *Selection) WithTag(name string) *Selection {
+	return s.Filter(func(f *Field) bool {
+		_, ok := f.structField.Tag.Lookup(name)
+		return ok
+	})
+}
+
+//WithoutTag returns the selection of fields lacking the tag
+func (s *Selection) WithoutTag(name string) *Selection {
+	return s.Filter(func(f *Field) bool {
+		_, ok := f.structField.Tag.Lookup(name)
+		return !ok
+	})
+}
+
+//NameMatches returns the selection of fields with the name matching the regular expression.
+//An invalid expression is reported as a failure and selects no fields
+func (s *Selection) NameMatches(expr string) *Selection {
+	s.assert.t.Helper()
+	if s.assert.failed {
+		return s
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		s.assert.fail(Failure{Field: s.assert.structName(), Problem: "Fields cannot be selected: " + err.Error()})
+		return &Selection{assert: s.assert}
+	}
+	return s.Filter(func(f *Field) bool {
+		return re.MatchString(f.name)
+	})
+}
+
+//AllHaveTag checks the existence of the tag in every field of the selection
+func (s *Selection) AllHaveTag(name string) *Selection {
+	s.assert.t.Helper()
+	for _, field := range s.fields {
+		field.HasTag(name)
+	}
+	return s
+}
+
+//NoneHaveTag checks the absence of the tag in every field of the selection
+func (s *Selection) NoneHaveTag(name string) *Selection {
+	s.assert.t.Helper()
+	for _, field := range s.fields {
+		field.NoTag(name)
+	}
+	return s
+}
@@ -0,0 +1,99 @@
+package assert
+
+import (
+	"reflect"
+	"testing"
+)
+
+//nolint
+type SelectionStruct struct {
+	ID        int    `json:"id" db:"id"`
+	Name      string `json:"name" db:"name"`
+	Password  string `db:"password"`
+	CreatedAt int64  `json:"created_at" bson:"created_at"`
+	internal  string
+	SubStruct
+}
+
+func TestEachField(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	var names []string
+	Expect(test.t, SelectionStruct{}).EachField(func(f *Field) {
+		names = append(names, f.name)
+	})
+	expected := []string{"ID", "Name", "Password", "CreatedAt", "SubStruct"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+
+	names = nil
+	Expect(test.t, SelectionStruct{}, WithUnexported()).EachField(func(f *Field) {
+		names = append(names, f.name)
+	})
+	expected = []string{"ID", "Name", "Password", "CreatedAt", "internal", "SubStruct"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+}
+
+func TestSelectionFilters(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	if names := Expect(test.t, SelectionStruct{}).Fields().Unexported().Names(); len(names) != 0 {
+		t.Errorf("Unexpected unexported fields %v", names)
+	}
+
+	fields := Expect(test.t, SelectionStruct{}, WithUnexported()).Fields()
+	cases := []struct {
+		Name      string
+		Selection *Selection
+		Expected  []string
+	}{
+		{"All", fields, []string{"ID", "Name", "Password", "CreatedAt", "internal", "SubStruct"}},
+		{"ByKind", fields.ByKind(reflect.Int, reflect.Int64), []string{"ID", "CreatedAt"}},
+		{"ByType", fields.ByType(""), []string{"Name", "Password", "internal"}},
+		{"Exported", fields.Exported(), []string{"ID", "Name", "Password", "CreatedAt", "SubStruct"}},
+		{"Unexported", fields.Unexported(), []string{"internal"}},
+		{"WithTag", fields.WithTag("json"), []string{"ID", "Name", "CreatedAt"}},
+		{"WithoutTag", fields.Exported().WithoutTag("json"), []string{"Password", "SubStruct"}},
+		{"NameMatches", fields.NameMatches("^(ID|.*At)$"), []string{"ID", "CreatedAt"}},
+		{"Filter", fields.Filter(func(f *Field) bool { return f.structField.Anonymous }), []string{"SubStruct"}},
+	}
+	for _, c := range cases {
+		if names := c.Selection.Names(); !reflect.DeepEqual(names, c.Expected) {
+			t.Errorf("%s: expected %v, got %v", c.Name, c.Expected, names)
+		}
+		if c.Selection.Len() != len(c.Expected) {
+			t.Errorf("%s: expected %d, got %d", c.Name, len(c.Expected), c.Selection.Len())
+		}
+	}
+}
+
+func TestSelectionAllNoneHaveTag(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	fields := Expect(test.t, SelectionStruct{}).Fields().Exported()
+	fields.NameMatches("^(ID|Name)$").AllHaveTag("db")
+
+	test.mockT.EXPECT().Errorf("%s: Fields cannot be selected: error parsing regexp: missing closing ): `(ID`", "SelectionStruct")
+	if selection := fields.NameMatches("(ID").AllHaveTag("json"); selection.Len() != 0 {
+		t.Errorf("Expected no fields, got %v", selection.Names())
+	}
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "SelectionStruct.CreatedAt", "db")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "SelectionStruct.SubStruct", "db")
+	fields.AllHaveTag("db")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is unexpected", "SelectionStruct.CreatedAt", "bson")
+	fields.NoneHaveTag("bson")
+}
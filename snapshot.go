@@ -0,0 +1,164 @@
+package assert
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+//updateEnv is the environment variable regenerating the golden files: TAGASSERT_UPDATE=1
+const updateEnv = "TAGASSERT_UPDATE"
+
+//updateFlag is the flag regenerating the golden files if the package under test defines it,
+//the library does not define the flag
+const updateFlag = "update"
+
+//snapshotDir is the directory of the golden files
+var snapshotDir = "testdata"
+
+//updateSnapshots reports whether the golden files must be regenerated
+//(TAGASSERT_UPDATE=1 or the -update flag of the package under test)
+func updateSnapshots() bool {
+	if update, err := strconv.ParseBool(os.Getenv(updateEnv)); err == nil && update {
+		return true
+	}
+	f := flag.Lookup(updateFlag)
+	if f == nil {
+		return false
+	}
+	getter, ok := f.Value.(flag.Getter)
+	if !ok {
+		return false
+	}
+	update, _ := getter.Get().(bool)
+	return update
+}
+
+//Snapshot compares the field paths, types and raw tags of the structure with the golden file
+//testdata/<test name>/<struct name>.tags. Run the tests with TAGASSERT_UPDATE=1 to regenerate the golden file
+func Snapshot(t Reporter, v interface{}) *StructAssert {
+	t.Helper()
+	return Expect(t, v).Snapshot()
+}
+
+//Snapshot compares the field paths, types and raw tags of the structure with the golden file
+//named after the structure. The golden files of a test are kept in the directory named after the test
+//if the Reporter has the Name of the test
+func (a *StructAssert) Snapshot() *StructAssert {
+	a.t.Helper()
+	if a.failed {
+		return a
+	}
+	actual := a.snapshot()
+	path := filepath.Join(snapshotDir, snapshotName(a.structName())+".tags")
+	if t, ok := a.t.(namer); ok {
+		path = filepath.Join(snapshotDir, snapshotName(t.Name()), snapshotName(a.structName())+".tags")
+	}
+
+	if updateSnapshots() {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			a.fail(Failure{Field: a.structName(), Problem: "Snapshot <" + path + "> is not updated: " + err.Error()})
+			return a
+		}
+		if err := os.WriteFile(path, []byte(actual), 0644); err != nil {
+			a.fail(Failure{Field: a.structName(), Problem: "Snapshot <" + path + "> is not updated: " + err.Error()})
+		}
+		return a
+	}
+
+	expected, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		a.fail(Failure{Field: a.structName(), Problem: "Snapshot <" + path + "> not found, run the test with " + updateEnv + "=1"})
+		return a
+	}
+	if err != nil {
+		a.fail(Failure{Field: a.structName(), Problem: "Snapshot <" + path + "> is not read: " + err.Error()})
+		return a
+	}
+	if string(expected) != actual {
+		a.fail(Failure{Field: a.structName(), Problem: "Snapshot <" + path + "> differs:\n" + diffLines(string(expected), actual)})
+	}
+	return a
+}
+
+//snapshot serializes the exported fields of the structure and the nested structures one per line
+func (a *StructAssert) snapshot() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", a.structName())
+	writeSnapshot(&b, a.structType(), "", map[reflect.Type]bool{})
+	return b.String()
+}
+
+func writeSnapshot(b *strings.Builder, vtype reflect.Type, prefix string, visited map[reflect.Type]bool) {
+	visited[vtype] = true
+	defer delete(visited, vtype)
+
+	for i := 0; i < vtype.NumField(); i++ {
+		structField := vtype.Field(i)
+		if structField.PkgPath != "" && !structField.Anonymous {
+			continue
+		}
+		path := prefix + structField.Name
+		fmt.Fprintf(b, "%s %s `%s`\n", path, structField.Type, structField.Tag)
+
+		ftype, containers := unwrapType(structField.Type)
+		if ftype.Kind() == reflect.Struct && !visited[ftype] {
+			writeSnapshot(b, ftype, path+containers+".", visited)
+		}
+	}
+}
+
+//snapshotName converts the name of the test to the name of the file
+func snapshotName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', ' ':
+			return '_'
+		}
+		return r
+	}, name)
+}
+
+//diffLines returns the lines removed from expected (-) and added to actual (+)
+func diffLines(expected, actual string) string {
+	a := strings.Split(strings.TrimSuffix(expected, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(actual, "\n"), "\n")
+
+	//lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var diff []string
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			diff = append(diff, "- "+a[i])
+			i++
+		default:
+			diff = append(diff, "+ "+b[j])
+			j++
+		}
+	}
+	return strings.Join(diff, "\n")
+}
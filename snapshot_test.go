@@ -0,0 +1,156 @@
+package assert
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+//nolint
+type SnapshotStruct struct {
+	ID    int `json:"id"`
+	Items []*OrderItem
+	Meta  map[string]Address `json:"meta,omitempty"`
+	Self  *SnapshotStruct
+	SubStruct
+	internal string
+}
+
+const snapshotGolden = "# SnapshotStruct\n" +
+	"ID int `json:\"id\"`\n" +
+	"Items []*assert.OrderItem ``\n" +
+	"Items[].SKU string `json:\"sku\"`\n" +
+	"Meta map[string]assert.Address `json:\"meta,omitempty\"`\n" +
+	"Meta{}.City string `json:\"city\"`\n" +
+	"Self *assert.SnapshotStruct ``\n" +
+	"SubStruct assert.SubStruct ``\n" +
+	"SubStruct.Name string ``\n"
+
+func setSnapshotDir(t *testing.T) string {
+	dir := snapshotDir
+	snapshotDir = filepath.Join(t.TempDir(), "testdata")
+	t.Cleanup(func() {
+		snapshotDir = dir
+	})
+	return snapshotDir
+}
+
+//update is defined as the package under test usually does, the library must not define it too
+var update = flag.Bool(updateFlag, false, "update the golden files")
+
+func setUpdate(t *testing.T) {
+	t.Setenv(updateEnv, "1")
+}
+
+func TestSnapshotUpdate(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	dir := setSnapshotDir(t)
+	setUpdate(t)
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+	test.mockT.EXPECT().Name().Return("TestDTO/case 1")
+	Snapshot(test.t, &SnapshotStruct{})
+
+	data, err := os.ReadFile(filepath.Join(dir, "TestDTO_case_1", "SnapshotStruct.tags"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != snapshotGolden {
+		t.Errorf("Expected\n%s\ngot\n%s", snapshotGolden, data)
+	}
+}
+
+func TestSnapshotCompare(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	dir := setSnapshotDir(t)
+	test.mockT.EXPECT().Helper().AnyTimes()
+	test.mockT.EXPECT().Name().Return("TestDTO").AnyTimes()
+	path := filepath.Join(dir, "TestDTO", "SnapshotStruct.tags")
+
+	test.mockT.EXPECT().Errorf("%s: Snapshot <"+path+"> not found, run the test with TAGASSERT_UPDATE=1", "SnapshotStruct")
+	Snapshot(test.t, SnapshotStruct{})
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(snapshotGolden), 0644); err != nil {
+		t.Fatal(err)
+	}
+	Snapshot(test.t, SnapshotStruct{})
+
+	changed := "# SnapshotStruct\n" +
+		"ID int `json:\"ID\"`\n" +
+		"Items []*assert.OrderItem ``\n" +
+		"Items[].SKU string `json:\"sku\"`\n" +
+		"Meta map[string]assert.Address `json:\"meta,omitempty\"`\n" +
+		"Meta{}.City string `json:\"city\"`\n" +
+		"Self *assert.SnapshotStruct ``\n" +
+		"SubStruct assert.SubStruct ``\n" +
+		"SubStruct.Name string ``\n" +
+		"Removed string ``\n"
+	if err := os.WriteFile(path, []byte(changed), 0644); err != nil {
+		t.Fatal(err)
+	}
+	test.mockT.EXPECT().Errorf("%s: Snapshot <"+path+"> differs:\n"+
+		"- ID int `json:\"ID\"`\n+ ID int `json:\"id\"`\n- Removed string ``", "SnapshotStruct")
+	Snapshot(test.t, SnapshotStruct{})
+}
+
+func TestSnapshotsOfTest(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	setSnapshotDir(t)
+	test.mockT.EXPECT().Helper().AnyTimes()
+	test.mockT.EXPECT().Name().Return("TestTwo").AnyTimes()
+
+	t.Setenv(updateEnv, "1")
+	Snapshot(test.t, SnapshotStruct{})
+	Snapshot(test.t, TestStruct{})
+
+	t.Setenv(updateEnv, "")
+	Snapshot(test.t, SnapshotStruct{})
+	Snapshot(test.t, TestStruct{})
+}
+
+func TestDiffLines(t *testing.T) {
+	cases := []struct {
+		Expected string
+		Actual   string
+		Diff     string
+	}{
+		{"a\nb\nc\n", "a\nb\nc\n", ""},
+		{"a\nb\nc\n", "a\nc\n", "- b"},
+		{"a\nc\n", "a\nb\nc\n", "+ b"},
+		{"a\nb\n", "a\nx\n", "- b\n+ x"},
+	}
+	for _, c := range cases {
+		if diff := diffLines(c.Expected, c.Actual); diff != c.Diff {
+			t.Errorf("Expected %q, got %q", c.Diff, diff)
+		}
+	}
+}
+
+func TestSnapshotUpdateFlag(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	dir := setSnapshotDir(t)
+	*update = true
+	defer func() {
+		*update = false
+	}()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+	test.mockT.EXPECT().Name().Return("TestFlag")
+	Snapshot(test.t, SnapshotStruct{})
+
+	if _, err := os.Stat(filepath.Join(dir, "TestFlag", "SnapshotStruct.tags")); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,148 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+//TagSyntaxError describes a problem of the raw struct tag
+type TagSyntaxError struct {
+	Tag    string
+	Offset int
+	Msg    string
+}
+
+func (e *TagSyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Offset)
+}
+
+//tagPair is a key:"value" pair of the struct tag
+type tagPair struct {
+	key   string
+	value string
+}
+
+//parseStructTag parses the raw struct tag into the key/value pairs
+//with the checks of the structtag analyzer of go vet
+func parseStructTag(tag reflect.StructTag) ([]tagPair, error) {
+	raw := string(tag)
+	var pairs []tagPair
+	seen := make(map[string]bool)
+	pos := 0
+	fail := func(offset int, format string, args ...interface{}) ([]tagPair, error) {
+		return nil, &TagSyntaxError{Tag: raw, Offset: offset, Msg: fmt.Sprintf(format, args...)}
+	}
+
+	for pos < len(raw) {
+		if pos > 0 && raw[pos] != ' ' {
+			return fail(pos, "struct tag pairs are not separated by a space")
+		}
+		for pos < len(raw) && raw[pos] == ' ' {
+			pos++
+		}
+		if pos == len(raw) {
+			break
+		}
+
+		i := pos
+		for i < len(raw) && raw[i] > ' ' && raw[i] != ':' && raw[i] != '"' && raw[i] != 0x7f {
+			i++
+		}
+		if i == pos {
+			return fail(pos, "bad syntax for struct tag key")
+		}
+		if i+1 >= len(raw) || raw[i] != ':' {
+			return fail(i, "bad syntax for struct tag pair")
+		}
+		if raw[i+1] != '"' {
+			return fail(i+1, "bad syntax for struct tag value")
+		}
+		key := raw[pos:i]
+		start := i + 1
+
+		i = start + 1
+		for i < len(raw) && raw[i] != '"' {
+			if raw[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(raw) {
+			return fail(start, "unterminated struct tag value")
+		}
+		value, err := strconv.Unquote(raw[start : i+1])
+		if err != nil {
+			return fail(start, "bad syntax for struct tag value")
+		}
+		if seen[key] {
+			return fail(pos, "duplicate struct tag key <%s>", key)
+		}
+		seen[key] = true
+		if offset, ok := suspiciousSpace(key, value); !ok {
+			return fail(start+1+offset, "suspicious space in struct tag value")
+		}
+		pairs = append(pairs, tagPair{key: key, value: value})
+		pos = i + 1
+	}
+	return pairs, nil
+}
+
+//asn1Key is the tag of encoding/asn1
+const asn1Key = "asn1"
+
+//suspiciousSpace checks the spaces in the values of json, xml and asn1 tags as go vet does.
+//The name in the json tag may have spaces, the xml tag may have one space between the namespace and the name,
+//the asn1 tag may not have spaces at all
+func suspiciousSpace(key, value string) (int, bool) {
+	switch key {
+	case asn1Key:
+		if i := strings.IndexByte(value, ' '); i >= 0 {
+			return i, false
+		}
+	case jsonKey:
+		if i := strings.IndexByte(value, ','); i >= 0 {
+			if j := strings.IndexByte(value[i:], ' '); j >= 0 {
+				return i + j, false
+			}
+		}
+	case xmlKey:
+		name := value
+		if i := strings.IndexByte(value, ','); i >= 0 {
+			if j := strings.IndexByte(value[i:], ' '); j >= 0 {
+				return i + j, false
+			}
+			name = value[:i]
+		}
+		if strings.HasPrefix(name, " ") {
+			return 0, false
+		}
+		if strings.HasSuffix(name, " ") || strings.Count(name, " ") > 1 {
+			return strings.LastIndexByte(name, ' '), false
+		}
+	}
+	return 0, true
+}
+
+//WellFormed checks the syntax of the raw tag of the field
+func (f *Field) WellFormed() *Field {
+	f.assert.t.Helper()
+	f.notApplicable("WellFormed")
+	if f.structField == nil {
+		return f
+	}
+	if _, err := parseStructTag(f.structField.Tag); err != nil {
+		f.fail(Failure{Problem: "Tag is malformed: " + err.Error()})
+	}
+	return f
+}
+
+//WellFormedTags checks the syntax of the raw tags of the fields of the structure
+func (a *StructAssert) WellFormedTags() *StructAssert {
+	a.t.Helper()
+	a.visibleFields().Each(func(f *Field) {
+		f.WellFormed()
+	})
+	return a
+}
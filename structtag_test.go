@@ -0,0 +1,88 @@
+package assert
+
+import (
+	"reflect"
+	"testing"
+)
+
+//structWithTag returns a value of the unnamed structure with the field Name having the raw tag.
+//The malformed tags are not declared literally to keep go vet quiet
+func structWithTag(tag string) interface{} {
+	vtype := reflect.StructOf([]reflect.StructField{
+		{Name: "Name", Type: reflect.TypeOf(""), Tag: reflect.StructTag(tag)},
+	})
+	return reflect.New(vtype).Elem().Interface()
+}
+
+func TestParseStructTag(t *testing.T) {
+	cases := []struct {
+		Tag    string
+		Pairs  []tagPair
+		Offset int
+		Msg    string
+	}{
+		{Tag: ``},
+		{Tag: `json:"name" xml:"ns name,attr"`, Pairs: []tagPair{{"json", "name"}, {"xml", "ns name,attr"}}},
+		{Tag: `  a:"\"quoted\""  b:""`, Pairs: []tagPair{{"a", `"quoted"`}, {"b", ""}}},
+		{Tag: `json: "name"`, Offset: 5, Msg: "bad syntax for struct tag value"},
+		{Tag: `json:"name`, Offset: 5, Msg: "unterminated struct tag value"},
+		{Tag: `json`, Offset: 4, Msg: "bad syntax for struct tag pair"},
+		{Tag: `:"name"`, Offset: 0, Msg: "bad syntax for struct tag key"},
+		{Tag: `json:name`, Offset: 5, Msg: "bad syntax for struct tag value"},
+		{Tag: `json:"a" json:"b"`, Offset: 9, Msg: "duplicate struct tag key <json>"},
+		{Tag: `json:"a"xml:"b"`, Offset: 8, Msg: "struct tag pairs are not separated by a space"},
+		{Tag: `json:"a\q"`, Offset: 5, Msg: "bad syntax for struct tag value"},
+		{Tag: `json:"name, omitempty"`, Offset: 11, Msg: "suspicious space in struct tag value"},
+		{Tag: `json:"first name"`, Pairs: []tagPair{{"json", "first name"}}},
+		{Tag: `json:"first name,omitempty"`, Pairs: []tagPair{{"json", "first name,omitempty"}}},
+		{Tag: `json:"first name,omit empty"`, Offset: 21, Msg: "suspicious space in struct tag value"},
+		{Tag: `xml:"a b c"`, Offset: 8, Msg: "suspicious space in struct tag value"},
+		{Tag: `xml:"ns name,attr"`, Pairs: []tagPair{{"xml", "ns name,attr"}}},
+		{Tag: `asn1:"optional,explicit,tag:1"`, Pairs: []tagPair{{"asn1", "optional,explicit,tag:1"}}},
+		{Tag: `asn1:"optional, explicit"`, Offset: 15, Msg: "suspicious space in struct tag value"},
+		{Tag: `db:"a b" asn1:" set"`, Offset: 15, Msg: "suspicious space in struct tag value"},
+	}
+	for _, c := range cases {
+		pairs, err := parseStructTag(reflect.StructTag(c.Tag))
+		if c.Msg == "" {
+			if err != nil {
+				t.Errorf("%q: unexpected error %v", c.Tag, err)
+			}
+			if !reflect.DeepEqual(pairs, c.Pairs) {
+				t.Errorf("%q: expected %v, got %v", c.Tag, c.Pairs, pairs)
+			}
+			continue
+		}
+		syntaxErr, ok := err.(*TagSyntaxError)
+		if !ok {
+			t.Errorf("%q: expected *TagSyntaxError, got %v", c.Tag, err)
+			continue
+		}
+		if syntaxErr.Msg != c.Msg || syntaxErr.Offset != c.Offset || syntaxErr.Tag != c.Tag {
+			t.Errorf("%q: expected %q at %d, got %q at %d", c.Tag, c.Msg, c.Offset, syntaxErr.Msg, syntaxErr.Offset)
+		}
+	}
+}
+
+func TestWellFormedTags(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	Expect(test.t, TestStruct{}).WellFormedTags()
+
+	assert := Expect(test.t, structWithTag(`json: "name"`))
+	test.mockT.EXPECT().Errorf("%s: Tag is malformed: bad syntax for struct tag value at position 5", "<Unnamed>.Name")
+	assert.WellFormedTags()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found, tag is malformed: bad syntax for struct tag value at position 5", "<Unnamed>.Name", "json")
+	assert.ExpectField("Name").HasTag("json")
+
+	//the json name may have spaces
+	Expect(test.t, structWithTag(`json:"first name" db:"first_name"`)).
+		WellFormedTags().
+		ExpectField("Name").
+		OnlyTags("json", "db").
+		ExactTags(map[string]string{"json": "first name", "db": "first_name"})
+}
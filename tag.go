@@ -1,10 +1,52 @@
 package assert
 
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
 //Tag of field
 type Tag struct {
 	Field *Field
 	Name  string
 	Value string
+
+	negate bool
+}
+
+//parseTagValue splits the value of a tag into the primary name and the list of options
+func parseTagValue(value string) (string, []string) {
+	parts := strings.Split(value, ",")
+	var options []string
+	for _, option := range parts[1:] {
+		if option != "" {
+			options = append(options, option)
+		}
+	}
+	return parts[0], options
+}
+
+//ValueName returns the primary name of the tag value (the part before the first comma)
+func (t *Tag) ValueName() string {
+	name, _ := parseTagValue(t.Value)
+	return name
+}
+
+//Options returns the ordered list of options of the tag value
+func (t *Tag) Options() []string {
+	_, options := parseTagValue(t.Value)
+	return options
+}
+
+//hasOption returns true if the tag value contains the option
+func (t *Tag) hasOption(option string) bool {
+	for _, o := range t.Options() {
+		if o == option {
+			return true
+		}
+	}
+	return false
 }
 
 //HasValue checks the tag for the specified value
@@ -16,21 +58,246 @@ func (t *Tag) HasValue(value string) bool {
 	return t.Value == value
 }
 
+//Not returns the tag with the next assertion inverted, the tag itself is not changed
+func (t *Tag) Not() *Tag {
+	negated := *t
+	negated.negate = !t.negate
+	return &negated
+}
+
+//not returns the state of the Not modifier and resets it
+func (t *Tag) not() bool {
+	negate := t.negate
+	t.negate = false
+	return negate
+}
+
+//fail reports the failure of the tag
+func (t *Tag) fail(failure Failure) {
+	t.Field.assert.t.Helper()
+	failure.Tag = t.Name
+	t.Field.fail(failure)
+}
+
 //Equal checks the tag for the specified value
 func (t *Tag) Equal(value string) *Tag {
+	if t.Field == nil {
+		t.not()
+		return t
+	}
 	t.Field.assert.t.Helper()
-	if t.Value != value {
-		t.Field.assert.t.Errorf("%s: Tag <%s> does not have a value of <%s>,but actual <%s>", t.Field.getFullName(), t.Name, value, t.Value)
-
+	switch not := t.not(); {
+	case !not && t.Value != value:
+		t.fail(Failure{Expected: value, Actual: t.Value, Problem: "does not have a value of"})
+	case not && t.Value == value:
+		t.fail(Failure{Expected: value, Problem: "must not have a value of"})
 	}
 	return t
 }
 
 //NotEmpty check for empty value
 func (t *Tag) NotEmpty() *Tag {
+	if t.Field == nil {
+		t.not()
+		return t
+	}
+	t.Field.assert.t.Helper()
+	switch not := t.not(); {
+	case !not && t.Value == "":
+		t.fail(Failure{Problem: "is empty"})
+	case not && t.Value != "":
+		t.fail(Failure{Actual: t.Value, Problem: "must be empty"})
+	}
+	return t
+}
+
+//HasName checks the primary name of the tag value regardless of the options
+func (t *Tag) HasName(name string) *Tag {
+	if t.Field == nil {
+		t.not()
+		return t
+	}
+	t.Field.assert.t.Helper()
+	switch actual, not := t.ValueName(), t.not(); {
+	case !not && actual != name:
+		t.fail(Failure{Expected: name, Actual: actual, Problem: "does not have a name of"})
+	case not && actual == name:
+		t.fail(Failure{Expected: name, Problem: "must not have a name of"})
+	}
+	return t
+}
+
+//HasOption checks the existence of the option in the tag value
+func (t *Tag) HasOption(option string) *Tag {
+	if t.Field == nil {
+		t.not()
+		return t
+	}
+	t.Field.assert.t.Helper()
+	if t.hasOption(option) == t.not() {
+		t.reportOption(option, !t.hasOption(option))
+	}
+	return t
+}
+
+//NoOption checks the absence of the option in the tag value
+func (t *Tag) NoOption(option string) *Tag {
+	if t.Field == nil {
+		t.not()
+		return t
+	}
+	t.Field.assert.t.Helper()
+	if t.hasOption(option) != t.not() {
+		t.reportOption(option, !t.hasOption(option))
+	}
+	return t
+}
+
+func (t *Tag) reportOption(option string, missing bool) {
+	t.Field.assert.t.Helper()
+	if missing {
+		t.fail(Failure{Expected: option, Problem: "does not have an option"})
+		return
+	}
+	t.fail(Failure{Expected: option, Problem: "has an unexpected option"})
+}
+
+//OptionsExactly checks that the tag value has exactly the specified options in any order
+func (t *Tag) OptionsExactly(options ...string) *Tag {
+	if t.Field == nil {
+		t.not()
+		return t
+	}
+	t.Field.assert.t.Helper()
+	actual := t.Options()
+	switch equal, not := equalOptions(actual, options), t.not(); {
+	case !not && !equal:
+		t.fail(Failure{
+			Expected: strings.Join(options, ","),
+			Actual:   strings.Join(actual, ","),
+			Problem:  "does not have exactly the options",
+		})
+	case not && equal:
+		t.fail(Failure{Expected: strings.Join(options, ","), Problem: "must not have exactly the options"})
+	}
+	return t
+}
+
+//Matches checks that the tag value matches the regular expression.
+//An invalid expression is reported as a failure
+func (t *Tag) Matches(expr string) *Tag {
+	if t.Field == nil {
+		t.not()
+		return t
+	}
+	t.Field.assert.t.Helper()
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		t.not()
+		t.fail(Failure{Problem: "cannot match: " + err.Error()})
+		return t
+	}
+	switch match, not := re.MatchString(t.Value), t.not(); {
+	case !not && !match:
+		t.fail(Failure{Expected: expr, Actual: t.Value, Problem: "does not match"})
+	case not && match:
+		t.fail(Failure{Expected: expr, Actual: t.Value, Problem: "must not match"})
+	}
+	return t
+}
+
+//HasPrefix checks that the tag value begins with the prefix
+func (t *Tag) HasPrefix(prefix string) *Tag {
+	if t.Field == nil {
+		t.not()
+		return t
+	}
+	t.Field.assert.t.Helper()
+	switch has, not := strings.HasPrefix(t.Value, prefix), t.not(); {
+	case !not && !has:
+		t.fail(Failure{Expected: prefix, Actual: t.Value, Problem: "does not have a prefix of"})
+	case not && has:
+		t.fail(Failure{Expected: prefix, Actual: t.Value, Problem: "must not have a prefix of"})
+	}
+	return t
+}
+
+//HasSuffix checks that the tag value ends with the suffix
+func (t *Tag) HasSuffix(suffix string) *Tag {
+	if t.Field == nil {
+		t.not()
+		return t
+	}
 	t.Field.assert.t.Helper()
-	if t.Value == "" {
-		t.Field.assert.t.Errorf("%s: Tag <%s> is empty", t.Field.getFullName(), t.Name)
+	switch has, not := strings.HasSuffix(t.Value, suffix), t.not(); {
+	case !not && !has:
+		t.fail(Failure{Expected: suffix, Actual: t.Value, Problem: "does not have a suffix of"})
+	case not && has:
+		t.fail(Failure{Expected: suffix, Actual: t.Value, Problem: "must not have a suffix of"})
 	}
 	return t
 }
+
+//Contains checks that the tag value contains the substring
+func (t *Tag) Contains(substr string) *Tag {
+	if t.Field == nil {
+		t.not()
+		return t
+	}
+	t.Field.assert.t.Helper()
+	switch has, not := strings.Contains(t.Value, substr), t.not(); {
+	case !not && !has:
+		t.fail(Failure{Expected: substr, Actual: t.Value, Problem: "does not contain"})
+	case not && has:
+		t.fail(Failure{Expected: substr, Actual: t.Value, Problem: "must not contain"})
+	}
+	return t
+}
+
+//OneOf checks that the tag value equals one of the values
+func (t *Tag) OneOf(values ...string) *Tag {
+	if t.Field == nil {
+		t.not()
+		return t
+	}
+	t.Field.assert.t.Helper()
+	switch has, not := oneOf(t.Value, values...), t.not(); {
+	case !not && !has:
+		t.fail(Failure{Expected: strings.Join(values, ", "), Actual: t.Value, Problem: "is not one of"})
+	case not && has:
+		t.fail(Failure{Expected: strings.Join(values, ", "), Actual: t.Value, Problem: "must not be one of"})
+	}
+	return t
+}
+
+//Satisfies checks the tag value with the function, a non-nil error is reported
+func (t *Tag) Satisfies(fn func(value string) error) *Tag {
+	if t.Field == nil {
+		t.not()
+		return t
+	}
+	t.Field.assert.t.Helper()
+	switch err, not := fn(t.Value), t.not(); {
+	case !not && err != nil:
+		t.fail(Failure{Actual: t.Value, Problem: "does not satisfy: " + err.Error()})
+	case not && err == nil:
+		t.fail(Failure{Actual: t.Value, Problem: "must not satisfy the condition"})
+	}
+	return t
+}
+
+func equalOptions(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := append([]string(nil), a...)
+	sb := append([]string(nil), b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
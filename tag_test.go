@@ -1,6 +1,8 @@
 package assert
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -61,7 +63,6 @@ func TestHasTag(t *testing.T) {
 	assert := Expect(test.t, TestStruct{})
 
 	test.mockT.EXPECT().Errorf("%s: Field <%s> is private", "TestStruct", "private")
-	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "TestStruct.private", "Unknown")
 	assert.ExpectField("private").HasTag("Unknown")
 
 	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "TestStruct.WithoutTags", "Unknown")
@@ -115,7 +116,6 @@ func TestExpectTag(t *testing.T) {
 	test.mockT.EXPECT().Helper().AnyTimes()
 
 	test.mockT.EXPECT().Errorf("%s: Field <%s> is private", "TestStruct", "private")
-	test.mockT.EXPECT().Errorf("%s: Tag <%s> not found", "TestStruct.private", "Unknown")
 
 	assert := Expect(test.t, TestStruct{})
 
@@ -130,3 +130,173 @@ func TestExpectTag(t *testing.T) {
 	assert.ExpectField("Public").ExpectTag("tag2")
 
 }
+
+func TestParseTagValue(t *testing.T) {
+	cases := []struct {
+		Value   string
+		Name    string
+		Options []string
+	}{
+		{Value: "", Name: "", Options: nil},
+		{Value: "name", Name: "name", Options: nil},
+		{Value: "name,omitempty", Name: "name", Options: []string{"omitempty"}},
+		{Value: ",omitempty,string", Name: "", Options: []string{"omitempty", "string"}},
+		{Value: "-,", Name: "-", Options: nil},
+		{Value: "name,,string", Name: "name", Options: []string{"string"}},
+	}
+	for _, c := range cases {
+		name, options := parseTagValue(c.Value)
+		if name != c.Name {
+			t.Errorf("%q: expected name %q, got %q", c.Value, c.Name, name)
+		}
+		if !reflect.DeepEqual(options, c.Options) {
+			t.Errorf("%q: expected options %v, got %v", c.Value, c.Options, options)
+		}
+	}
+}
+
+func TestTagNameAndOptions(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	tag := Expect(test.t, TestStruct{}).ExpectField("Public").ExpectTag("tag2")
+	if tag.ValueName() != "public" {
+		t.Errorf("Expected %q, got %q", "public", tag.ValueName())
+	}
+
+	tag.HasName("public").
+		HasOption("options").
+		NoOption("omitempty").
+		OptionsExactly("options")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a name of <%s>,but actual <%s>", "TestStruct.Public", "tag2", "pub", "public")
+	tag.HasName("pub")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have an option <%s>", "TestStruct.Public", "tag2", "omitempty")
+	tag.HasOption("omitempty")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> has an unexpected option <%s>", "TestStruct.Public", "tag2", "options")
+	tag.NoOption("options")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have exactly the options <%s>,but actual <%s>", "TestStruct.Public", "tag2", "options,string", "options")
+	tag.OptionsExactly("options", "string")
+}
+
+func TestTagOptionsOrder(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	Expect(test.t, &struct {
+		ID int `json:"id,string,omitempty"`
+	}{}).ExpectField("ID").ExpectTag("json").
+		HasName("id").
+		OptionsExactly("omitempty", "string")
+}
+
+func TestTagMatchers(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	tag := Expect(test.t, &struct {
+		Age int `validate:"required,max=120"`
+	}{}).ExpectField("Age").ExpectTag("validate")
+
+	tag.Matches(`max=\d+$`).
+		HasPrefix("required").
+		HasSuffix("=120").
+		Contains("max=").
+		OneOf("required", "required,max=120").
+		Satisfies(func(value string) error {
+			return nil
+		})
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not match <%s>,but actual <%s>", "<Unnamed>.Age", "validate", `^min=`, "required,max=120")
+	tag.Matches(`^min=`)
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> cannot match: error parsing regexp: missing closing ]: `[a-z`", "<Unnamed>.Age", "validate").Times(2)
+	tag.Matches(`[a-z`)
+	tag.Not().Matches(`[a-z`).Matches(`max=`)
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a prefix of <%s>,but actual <%s>", "<Unnamed>.Age", "validate", "omitempty", "required,max=120")
+	tag.HasPrefix("omitempty")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a suffix of <%s>,but actual <%s>", "<Unnamed>.Age", "validate", "=100", "required,max=120")
+	tag.HasSuffix("=100")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not contain <%s>,but actual <%s>", "<Unnamed>.Age", "validate", "min=", "required,max=120")
+	tag.Contains("min=")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is not one of <%s>,but actual <%s>", "<Unnamed>.Age", "validate", "a, b", "required,max=120")
+	tag.OneOf("a", "b")
+
+	errMax := errors.New("max is too big")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not satisfy: max is too big,but actual <%s>", "<Unnamed>.Age", "validate", "required,max=120")
+	tag.Satisfies(func(value string) error {
+		return errMax
+	})
+}
+
+func TestTagNot(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	tag := Expect(test.t, TestStruct{}).ExpectField("Public").ExpectTag("tag2")
+	tag.Not().Equal("-").
+		NotEmpty().
+		Not().HasName("pub").
+		Not().HasOption("omitempty").
+		Not().NoOption("options").
+		Not().OptionsExactly().
+		Not().Matches("^-$").
+		Not().HasPrefix("-").
+		Not().HasSuffix("-").
+		Not().Contains("-").
+		Not().OneOf("-", "").
+		Not().Satisfies(func(string) error { return errors.New("fail") }).
+		Equal("public,options")
+
+	name := "TestStruct.Public"
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> must not have a value of <%s>", name, "tag2", "public,options")
+	tag.Not().Equal("public,options")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> must be empty,but actual <%s>", name, "tag2", "public,options")
+	tag.Not().NotEmpty()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> must not have a name of <%s>", name, "tag2", "public")
+	tag.Not().HasName("public")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> has an unexpected option <%s>", name, "tag2", "options")
+	tag.Not().HasOption("options")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have an option <%s>", name, "tag2", "string")
+	tag.Not().NoOption("string")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> must not have exactly the options <%s>", name, "tag2", "options")
+	tag.Not().OptionsExactly("options")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> must not match <%s>,but actual <%s>", name, "tag2", "^pub", "public,options")
+	tag.Not().Matches("^pub")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> must not have a prefix of <%s>,but actual <%s>", name, "tag2", "pub", "public,options")
+	tag.Not().HasPrefix("pub")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> must not have a suffix of <%s>,but actual <%s>", name, "tag2", "options", "public,options")
+	tag.Not().HasSuffix("options")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> must not contain <%s>,but actual <%s>", name, "tag2", ",", "public,options")
+	tag.Not().Contains(",")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> must not be one of <%s>,but actual <%s>", name, "tag2", "-, public,options", "public,options")
+	tag.Not().OneOf("-", "public,options")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> must not satisfy the condition,but actual <%s>", name, "tag2", "public,options")
+	tag.Not().Satisfies(func(string) error { return nil })
+}
@@ -0,0 +1,154 @@
+package assert
+
+import (
+	"reflect"
+	"strings"
+)
+
+//taggedName is the serialized name of the field taken from the tag
+type taggedName struct {
+	name string
+	path string
+	//attr is set for the xml attributes, they do not collide with the elements
+	attr bool
+}
+
+//defaultTagNames are the names used by the serializers for a field without a name in the tag.
+//encoding/json and encoding/xml use the name of the field as is
+var defaultTagNames = map[string]NamingStrategy{
+	"bson": strings.ToLower, //go.mongodb.org/mongo-driver
+	"db":   strings.ToLower, //github.com/jmoiron/sqlx
+}
+
+//DefaultTagName sets the name of a field without a name in the tag for UniqueTagValues,
+//by default it is the name of the field lowercased for bson and db and the name of the field for other tags
+func DefaultTagName(key string, strategy NamingStrategy) Option {
+	return func(a *StructAssert) {
+		if a.tagNames == nil {
+			a.tagNames = make(map[string]NamingStrategy)
+		}
+		a.tagNames[key] = strategy
+	}
+}
+
+//defaultTagName returns the naming of a field without a name in the tag
+func (a *StructAssert) defaultTagName(key string) NamingStrategy {
+	if strategy, ok := a.tagNames[key]; ok {
+		return strategy
+	}
+	if strategy, ok := defaultTagNames[key]; ok {
+		return strategy
+	}
+	return func(name string) string {
+		return name
+	}
+}
+
+//collectTagNames returns the serialized names of the fields of the struct type.
+//Fields without a name in the tag use the name of the field converted by defaultName,
+//embedded structures without a name in the tag are inlined.
+//For the xml tag XMLName and the fields of the chardata, innerxml and comment modes have no name
+func collectTagNames(vtype reflect.Type, key, prefix string, defaultName NamingStrategy,
+	visited map[reflect.Type]bool) []taggedName {
+	visited[vtype] = true
+	defer delete(visited, vtype)
+
+	var names []taggedName
+	for i := 0; i < vtype.NumField(); i++ {
+		structField := vtype.Field(i)
+		value := structField.Tag.Get(key)
+		if value == "-" {
+			continue
+		}
+		name, options := parseTagValue(value)
+		path := prefix + structField.Name
+
+		ftype := structField.Type
+		if ftype.Kind() == reflect.Ptr {
+			ftype = ftype.Elem()
+		}
+		if structField.Anonymous && name == "" && ftype.Kind() == reflect.Struct {
+			if !visited[ftype] {
+				names = append(names, collectTagNames(ftype, key, path+".", defaultName, visited)...)
+			}
+			continue
+		}
+		if structField.PkgPath != "" {
+			continue
+		}
+		attr := false
+		if key == xmlKey {
+			if structField.Name == xmlName {
+				continue
+			}
+			switch (&XMLTag{flags: options}).mode() {
+			case "", xmlAny:
+			case xmlAttr, xmlAny + "," + xmlAttr:
+				attr = true
+			default:
+				continue
+			}
+		}
+		if name == "" {
+			name = defaultName(structField.Name)
+		}
+		names = append(names, taggedName{name: name, path: path, attr: attr})
+	}
+	return names
+}
+
+//UniqueTagValues checks that the names in the tag are unique across the fields of the structure
+//including the fields promoted from embedded structures
+func (a *StructAssert) UniqueTagValues(name string) *StructAssert {
+	a.t.Helper()
+	a.uniqueTagValues(name, false)
+	return a
+}
+
+//UniqueTagValuesFold is like UniqueTagValues but the names that differ only in case are colliding too,
+//as encoding/json matches the keys case-insensitively
+func (a *StructAssert) UniqueTagValuesFold(name string) *StructAssert {
+	a.t.Helper()
+	a.uniqueTagValues(name, true)
+	return a
+}
+
+func (a *StructAssert) uniqueTagValues(key string, fold bool) {
+	a.t.Helper()
+	if a.failed {
+		return
+	}
+	var order []taggedName
+	groups := make(map[taggedName][]taggedName)
+	for _, tagged := range collectTagNames(a.structType(), key, "", a.defaultTagName(key), make(map[reflect.Type]bool)) {
+		group := taggedName{name: tagged.name, attr: tagged.attr}
+		if fold {
+			group.name = strings.ToLower(group.name)
+		}
+		if _, ok := groups[group]; !ok {
+			order = append(order, group)
+		}
+		groups[group] = append(groups[group], tagged)
+	}
+
+	for _, group := range order {
+		tagged := groups[group]
+		if len(tagged) < 2 {
+			continue
+		}
+		var names, paths []string
+		seen := make(map[string]bool)
+		for _, t := range tagged {
+			paths = append(paths, t.path)
+			if !seen[t.name] {
+				seen[t.name] = true
+				names = append(names, t.name)
+			}
+		}
+		a.fail(Failure{
+			Field:   a.structName(),
+			Tag:     key,
+			Problem: "value <" + strings.Join(names, ", ") + "> is used by fields <" + strings.Join(paths, ", ") + ">",
+		})
+	}
+}
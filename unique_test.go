@@ -0,0 +1,80 @@
+package assert
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+//nolint
+type (
+	UniqueBase struct {
+		ID   int    `json:"id" db:"id"`
+		Kind string `json:"kind"`
+	}
+	UniqueStruct struct {
+		UniqueBase
+		Name     string `json:"name" db:"name"`
+		Title    string `json:"Name" db:"title"`
+		Code     string `json:"id" db:"code"`
+		Skip     string `json:"-" db:"-"`
+		Other    string `json:"-" db:"-"`
+		Kind     string `db:"type"`
+		internal string `db:"name"`
+	}
+	UniqueXML struct {
+		XMLName xml.Name `xml:"item"`
+		ID      int      `xml:"id,attr"`
+		Code    string   `xml:"id"`
+		Ident   string   `xml:"ID,foo"`
+		Name    string   `xml:"name,attr"`
+		Title   string   `xml:"Name,attr,omitempty"`
+		Text    string   `xml:",chardata"`
+		Item    string   `xml:"item"`
+	}
+	UniqueDefault struct {
+		Name  string
+		Other string `bson:"name" db:"name" json:"name"`
+		Title string `bson:"title" db:"Title"`
+	}
+	UniqueRecursive struct {
+		*UniqueRecursive
+		ID int `json:"id"`
+	}
+)
+
+func TestUniqueTagValues(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, UniqueStruct{})
+	assert.UniqueTagValues("db").UniqueTagValuesFold("db")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> value <id> is used by fields <UniqueBase.ID, Code>", "UniqueStruct", "json")
+	assert.UniqueTagValues("json")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> value <id> is used by fields <UniqueBase.ID, Code>", "UniqueStruct", "json")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> value <kind, Kind> is used by fields <UniqueBase.Kind, Kind>", "UniqueStruct", "json")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> value <name, Name> is used by fields <Name, Title>", "UniqueStruct", "json")
+	assert.UniqueTagValuesFold("json")
+
+	Expect(test.t, UniqueRecursive{}).UniqueTagValues("json")
+
+	assert = Expect(test.t, UniqueXML{})
+	assert.UniqueTagValues("xml")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> value <id, ID> is used by fields <Code, Ident>", "UniqueXML", "xml")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> value <name, Name> is used by fields <Name, Title>", "UniqueXML", "xml")
+	assert.UniqueTagValuesFold("xml")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> value <name> is used by fields <Name, Other>", "UniqueDefault", "bson")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> value <name> is used by fields <Name, Other>", "UniqueDefault", "db")
+	Expect(test.t, UniqueDefault{}).UniqueTagValues("json").UniqueTagValues("bson").UniqueTagValues("db")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> value <name> is used by fields <Name, Other>", "UniqueDefault", "json")
+	Expect(test.t, UniqueDefault{}, DefaultTagName("json", strings.ToLower), DefaultTagName("db", strings.ToUpper)).
+		UniqueTagValues("json").
+		UniqueTagValues("db")
+}
@@ -0,0 +1,340 @@
+package assert
+
+import (
+	"encoding"
+	"encoding/xml"
+	"reflect"
+	"strings"
+)
+
+const (
+	xmlKey  = "xml"
+	xmlName = "XMLName"
+)
+
+//flags of encoding/xml
+const (
+	xmlAttr      = "attr"
+	xmlCData     = "cdata"
+	xmlCharData  = "chardata"
+	xmlInnerXML  = "innerxml"
+	xmlComment   = "comment"
+	xmlAny       = "any"
+	xmlOmitEmpty = "omitempty"
+)
+
+var (
+	xmlNameType       = reflect.TypeOf(xml.Name{})
+	xmlMarshalerAttr  = reflect.TypeOf((*xml.MarshalerAttr)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+//XMLTag contains methods for verifying the xml tag with the semantics of encoding/xml
+type XMLTag struct {
+	*Tag
+	space   string
+	local   string
+	parents []string
+	flags   []string
+	err     string
+}
+
+//ExpectXML waiting for a xml tag to verify assert
+func (f *Field) ExpectXML() *XMLTag {
+	f.assert.t.Helper()
+	tag := &XMLTag{Tag: f.ExpectTag(xmlKey)}
+	tag.parse()
+	return tag
+}
+
+//parse splits the value of the xml tag as encoding/xml does:
+//"namespace parent>child>name,flag,flag"
+func (x *XMLTag) parse() {
+	if x.Field == nil {
+		return
+	}
+	tokens := strings.Split(x.Value, ",")
+	name := tokens[0]
+	for _, flag := range tokens[1:] {
+		if flag != "" {
+			x.flags = append(x.flags, flag)
+		}
+	}
+	if i := strings.Index(name, " "); i >= 0 {
+		x.space, name = name[:i], name[i+1:]
+	}
+
+	mode := x.mode()
+	isXMLName := x.Field.structField != nil && x.Field.structField.Name == xmlName
+	switch {
+	case isXMLName && mode != "":
+		x.err = "mode flags are not allowed on " + xmlName
+		return
+	case strings.Contains(mode, ",") && mode != xmlAny+","+xmlAttr:
+		x.err = "multiple modes <" + mode + ">"
+		return
+	case mode != "" && mode != xmlAttr && name != "":
+		x.err = "name is not allowed with <" + mode + ">"
+		return
+	case x.hasFlag(xmlOmitEmpty) && mode != "" && mode != xmlAttr && mode != xmlAny && mode != xmlAny+","+xmlAttr:
+		x.err = "omitempty is not allowed with <" + mode + ">"
+		return
+	}
+
+	if name == "" {
+		if x.space != "" {
+			x.err = "namespace <" + x.space + "> without name"
+		}
+		return
+	}
+	parents := strings.Split(name, ">")
+	if parents[len(parents)-1] == "" {
+		x.err = "trailing '>'"
+		return
+	}
+	if parents[0] == "" && x.Field.structField != nil {
+		parents[0] = x.Field.structField.Name
+	}
+	x.local = parents[len(parents)-1]
+	if len(parents) > 1 {
+		if mode != "" && mode != xmlAny {
+			x.err = "path <" + name + "> is not allowed with <" + mode + ">"
+			return
+		}
+		x.parents = parents[:len(parents)-1]
+	}
+}
+
+//mode returns the mode flags of the tag separated by commas, empty for an element.
+//As in encoding/xml the unknown flags are ignored and a repeated flag counts once
+func (x *XMLTag) mode() string {
+	var modes []string
+	seen := make(map[string]bool)
+	for _, flag := range x.flags {
+		switch flag {
+		case xmlAttr, xmlCData, xmlCharData, xmlInnerXML, xmlComment, xmlAny:
+			if !seen[flag] {
+				seen[flag] = true
+				modes = append(modes, flag)
+			}
+		}
+	}
+	if len(modes) == 2 && (modes[0] == xmlAttr && modes[1] == xmlAny || modes[0] == xmlAny && modes[1] == xmlAttr) {
+		return xmlAny + "," + xmlAttr
+	}
+	return strings.Join(modes, ",")
+}
+
+func (x *XMLTag) hasFlag(flag string) bool {
+	for _, f := range x.flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+//LocalName returns the local name of the element or attribute
+func (x *XMLTag) LocalName() string {
+	if x.Value == "-" {
+		return ""
+	}
+	if x.local == "" && x.Field != nil && x.Field.structField != nil {
+		return x.Field.structField.Name
+	}
+	return x.local
+}
+
+//Flags returns the flags of the xml tag
+func (x *XMLTag) Flags() []string {
+	return x.flags
+}
+
+func (x *XMLTag) expectFlag(flag string) *XMLTag {
+	if x.Field == nil {
+		return x
+	}
+	x.Field.assert.t.Helper()
+	if !x.hasFlag(flag) {
+		x.fail(Failure{Expected: flag, Problem: "does not have a flag"})
+	}
+	return x
+}
+
+//IsAttr checks the attr flag
+func (x *XMLTag) IsAttr() *XMLTag {
+	return x.expectFlag(xmlAttr)
+}
+
+//IsCharData checks the chardata flag
+func (x *XMLTag) IsCharData() *XMLTag {
+	return x.expectFlag(xmlCharData)
+}
+
+//IsCData checks the cdata flag
+func (x *XMLTag) IsCData() *XMLTag {
+	return x.expectFlag(xmlCData)
+}
+
+//IsInnerXML checks the innerxml flag
+func (x *XMLTag) IsInnerXML() *XMLTag {
+	return x.expectFlag(xmlInnerXML)
+}
+
+//IsComment checks the comment flag
+func (x *XMLTag) IsComment() *XMLTag {
+	return x.expectFlag(xmlComment)
+}
+
+//IsAny checks the any flag
+func (x *XMLTag) IsAny() *XMLTag {
+	return x.expectFlag(xmlAny)
+}
+
+//OmitEmpty checks the omitempty flag
+func (x *XMLTag) OmitEmpty() *XMLTag {
+	return x.expectFlag(xmlOmitEmpty)
+}
+
+//IsElement checks that the field is mapped to an element
+func (x *XMLTag) IsElement() *XMLTag {
+	if x.Field == nil {
+		return x
+	}
+	x.Field.assert.t.Helper()
+	if mode := x.mode(); mode != "" && mode != xmlAny {
+		x.fail(Failure{Actual: mode, Problem: "is not an element"})
+	}
+	return x
+}
+
+//HasLocalName checks the local name of the element or attribute
+func (x *XMLTag) HasLocalName(name string) *XMLTag {
+	if x.Field == nil {
+		return x
+	}
+	x.Field.assert.t.Helper()
+	if actual := x.LocalName(); actual != name {
+		x.fail(Failure{Expected: name, Actual: actual, Problem: "does not have a name of"})
+	}
+	return x
+}
+
+//Path checks the chain of the parent elements and the name (a>b>c)
+func (x *XMLTag) Path(names ...string) *XMLTag {
+	if x.Field == nil {
+		return x
+	}
+	x.Field.assert.t.Helper()
+	actual := strings.Join(append(append([]string(nil), x.parents...), x.LocalName()), ">")
+	if expected := strings.Join(names, ">"); actual != expected {
+		x.fail(Failure{Expected: expected, Actual: actual, Problem: "does not have a path of"})
+	}
+	return x
+}
+
+//Namespace checks the namespace of the element or attribute
+func (x *XMLTag) Namespace(space string) *XMLTag {
+	if x.Field == nil {
+		return x
+	}
+	x.Field.assert.t.Helper()
+	if x.space != space {
+		x.fail(Failure{Expected: space, Actual: x.space, Problem: "does not have a namespace of"})
+	}
+	return x
+}
+
+//Valid checks the grammar of the xml tag and that the flags are applicable to the type of the field
+func (x *XMLTag) Valid() *XMLTag {
+	if x.Field == nil || x.Field.structField == nil {
+		return x
+	}
+	x.Field.assert.t.Helper()
+	if x.err != "" {
+		x.fail(Failure{Problem: "is malformed: " + x.err})
+		return x
+	}
+	if x.Value == "-" {
+		return x
+	}
+
+	ftype := x.Field.structField.Type
+	if x.Field.structField.Name == xmlName {
+		if ftype != xmlNameType {
+			x.fail(Failure{Expected: ftype.String(), Problem: "is not applicable to type"})
+		}
+		return x
+	}
+
+	mode := x.mode()
+	if !xmlModeApplicable(ftype, mode) {
+		x.fail(Failure{Expected: ftype.String(), Problem: "flag <" + mode + "> is not applicable to type"})
+	}
+	return x
+}
+
+//xmlModeApplicable reports whether encoding/xml can marshal the type with the mode
+func xmlModeApplicable(t reflect.Type, mode string) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch mode {
+	case xmlAttr, xmlAny + "," + xmlAttr:
+		if t.Kind() != reflect.Struct || t == xmlNameType {
+			return true
+		}
+		return implementsAny(t, xmlMarshalerAttr, textMarshalerType)
+	case xmlCharData, xmlCData:
+		switch t.Kind() {
+		case reflect.Struct, reflect.Map, reflect.Chan, reflect.Func, reflect.Interface:
+			return implementsAny(t, textMarshalerType)
+		case reflect.Slice, reflect.Array:
+			return t.Elem().Kind() == reflect.Uint8
+		}
+		return true
+	case xmlInnerXML, xmlComment:
+		return t.Kind() == reflect.String || t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+	}
+	return true
+}
+
+//implementsAny reports whether the type or the pointer to it implements any of the interfaces
+func implementsAny(t reflect.Type, ifaces ...reflect.Type) bool {
+	for _, iface := range ifaces {
+		if t.Implements(iface) || reflect.PtrTo(t).Implements(iface) {
+			return true
+		}
+	}
+	return false
+}
+
+//ValidXML checks the xml tags of the fields of the structure
+//and that the chardata, innerxml and comment flags are used at most once
+func (a *StructAssert) ValidXML() *StructAssert {
+	a.t.Helper()
+	used := make(map[string]string)
+	a.visibleFields().Each(func(field *Field) {
+		structField := field.structField
+		if _, ok := structField.Tag.Lookup(xmlKey); !ok {
+			return
+		}
+		tag := field.ExpectXML().Valid()
+		if tag.err != "" {
+			return
+		}
+		mode := tag.mode()
+		if mode == xmlCData {
+			mode = xmlCharData
+		}
+		switch mode {
+		case xmlCharData, xmlInnerXML, xmlComment:
+			if other, ok := used[mode]; ok {
+				a.fail(Failure{Field: a.structName(), Problem: "Fields <" + other + "> and <" + structField.Name + "> both have the flag <" + mode + ">"})
+				return
+			}
+			used[mode] = structField.Name
+		}
+	})
+	return a
+}
@@ -0,0 +1,141 @@
+package assert
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+	"time"
+)
+
+//nolint
+type XMLStruct struct {
+	XMLName  xml.Name  `xml:"urn:test order"`
+	ID       int       `xml:"id,attr"`
+	Created  time.Time `xml:"created,attr,omitempty"`
+	Customer string    `xml:"customer>info>name"`
+	Text     string    `xml:",chardata"`
+	Inner    []byte    `xml:",innerxml"`
+	Note     string    `xml:",comment"`
+	Rest     []string  `xml:",any"`
+	Extra    string    `xml:",any,attr,omitempty"`
+	Custom   string    `xml:"custom,foo"`
+	Skip     string    `xml:"-"`
+	Untagged string
+}
+
+//nolint
+type XMLInvalidStruct struct {
+	Sub      SubStruct `xml:"sub,attr"`
+	Text     string    `xml:",chardata"`
+	Data     []byte    `xml:",cdata"`
+	Modes    string    `xml:",attr,chardata"`
+	Named    string    `xml:"named,innerxml"`
+	Omit     string    `xml:",comment,omitempty"`
+	Path     string    `xml:"a>b,attr"`
+	Trailing string    `xml:"a>"`
+	Comment  int       `xml:",comment"`
+}
+
+func TestXMLTagParse(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	assert := Expect(test.t, XMLStruct{})
+	assert.ExpectField("XMLName").ExpectXML().Namespace("urn:test").HasLocalName("order")
+	assert.ExpectField("ID").ExpectXML().IsAttr().HasLocalName("id").Namespace("")
+	assert.ExpectField("Created").ExpectXML().IsAttr().OmitEmpty()
+	assert.ExpectField("Customer").ExpectXML().IsElement().Path("customer", "info", "name")
+	assert.ExpectField("Text").ExpectXML().IsCharData()
+	assert.ExpectField("Inner").ExpectXML().IsInnerXML()
+	assert.ExpectField("Note").ExpectXML().IsComment()
+	assert.ExpectField("Rest").ExpectXML().IsAny().IsElement().HasLocalName("Rest")
+	assert.ExpectField("Extra").ExpectXML().IsAny().IsAttr().OmitEmpty()
+	assert.ExpectField("Custom").ExpectXML().IsElement().HasLocalName("custom")
+
+	tag := assert.ExpectField("Customer").ExpectXML()
+	if tag.LocalName() != "name" {
+		t.Errorf("Expected %q, got %q", "name", tag.LocalName())
+	}
+	if flags := assert.ExpectField("Created").ExpectXML().Flags(); !reflect.DeepEqual(flags, []string{"attr", "omitempty"}) {
+		t.Errorf("Unexpected flags %v", flags)
+	}
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a flag <%s>", "XMLStruct.Customer", "xml", "attr")
+	assert.ExpectField("Customer").ExpectXML().IsAttr()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is not an element,but actual <%s>", "XMLStruct.ID", "xml", "attr")
+	assert.ExpectField("ID").ExpectXML().IsElement()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a path of <%s>,but actual <%s>", "XMLStruct.Customer", "xml", "customer>name", "customer>info>name")
+	assert.ExpectField("Customer").ExpectXML().Path("customer", "name")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a namespace of <%s>,but actual <%s>", "XMLStruct.XMLName", "xml", "urn:other", "urn:test")
+	assert.ExpectField("XMLName").ExpectXML().Namespace("urn:other")
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> does not have a name of <%s>,but actual <%s>", "XMLStruct.ID", "xml", "ID", "id")
+	assert.ExpectField("ID").ExpectXML().HasLocalName("ID")
+}
+
+func TestValidXML(t *testing.T) {
+	test := setUp(t)
+	defer test.tearDown()
+
+	test.mockT.EXPECT().Helper().AnyTimes()
+
+	Expect(test.t, XMLStruct{}).ValidXML()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> flag <attr> is not applicable to type <%s>", "XMLInvalidStruct.Sub", "xml", "assert.SubStruct")
+	test.mockT.EXPECT().Errorf("%s: Fields <Text> and <Data> both have the flag <chardata>", "XMLInvalidStruct")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is malformed: multiple modes <attr,chardata>", "XMLInvalidStruct.Modes", "xml")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is malformed: name is not allowed with <innerxml>", "XMLInvalidStruct.Named", "xml")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is malformed: omitempty is not allowed with <comment>", "XMLInvalidStruct.Omit", "xml")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is malformed: path <a>b> is not allowed with <attr>", "XMLInvalidStruct.Path", "xml")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is malformed: trailing '>'", "XMLInvalidStruct.Trailing", "xml")
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> flag <comment> is not applicable to type <%s>", "XMLInvalidStruct.Comment", "xml", "int")
+
+	Expect(test.t, XMLInvalidStruct{}).ValidXML()
+
+	type XMLNameStruct struct {
+		XMLName xml.Name `xml:"urn:test order,omitempty"`
+	}
+	Expect(test.t, XMLNameStruct{}).ValidXML()
+
+	type XMLNameModeStruct struct {
+		XMLName xml.Name `xml:"order,attr"`
+	}
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is malformed: mode flags are not allowed on XMLName", "XMLNameModeStruct.XMLName", "xml")
+	Expect(test.t, XMLNameModeStruct{}).ValidXML()
+
+	private := reflect.StructOf([]reflect.StructField{
+		{Name: "text", PkgPath: "assert", Type: reflect.TypeOf(""), Tag: `xml:",chardata"`},
+		{Name: "data", PkgPath: "assert", Type: reflect.TypeOf(""), Tag: `xml:",chardata"`},
+	})
+	ExpectReflectType(test.t, private).ValidXML()
+	test.mockT.EXPECT().Errorf("%s: Fields <text> and <data> both have the flag <chardata>", "Unnamed")
+	ExpectReflectType(test.t, private, WithUnexported()).ValidXML()
+
+	test.mockT.EXPECT().Errorf("%s: Tag <%s> is malformed: namespace <urn:y> without name", "<Unnamed>.Name", "xml")
+	Expect(test.t, structWithTag(`xml:"urn:y ,attr"`)).ValidXML()
+}
+
+func TestXMLModeApplicable(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Result bool
+	}{
+		{"attr on time.Time", xmlModeApplicable(reflect.TypeOf(time.Time{}), "attr")},
+		{"attr on *int", xmlModeApplicable(reflect.TypeOf(new(int)), "attr")},
+		{"chardata on []byte", xmlModeApplicable(reflect.TypeOf([]byte{}), "chardata")},
+		{"innerxml on string", xmlModeApplicable(reflect.TypeOf(""), "innerxml")},
+		{"not attr on struct", !xmlModeApplicable(reflect.TypeOf(SubStruct{}), "attr")},
+		{"not chardata on []int", !xmlModeApplicable(reflect.TypeOf([]int{}), "chardata")},
+		{"not innerxml on int", !xmlModeApplicable(reflect.TypeOf(0), "innerxml")},
+	}
+	for _, c := range cases {
+		if !c.Result {
+			t.Errorf("Unexpected result: %s", c.Name)
+		}
+	}
+}